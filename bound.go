@@ -0,0 +1,180 @@
+package orderedmap
+
+import "iter"
+
+// boundKind tells how a Bound limits a range.
+type boundKind int
+
+const (
+	unbounded boundKind = iota
+	inclusive
+	exclusive
+)
+
+// Bound is one end of a key range. It is either inclusive or exclusive of its
+// key, or unbounded. The zero value of Bound is unbounded.
+type Bound[K any] struct {
+	key  K
+	kind boundKind
+}
+
+// Inclusive returns a bound that includes key.
+func Inclusive[K any](key K) Bound[K] {
+	return Bound[K]{key: key, kind: inclusive}
+}
+
+// Exclusive returns a bound that excludes key.
+func Exclusive[K any](key K) Bound[K] {
+	return Bound[K]{key: key, kind: exclusive}
+}
+
+// Unbounded returns a bound that does not limit the range.
+func Unbounded[K any]() Bound[K] {
+	return Bound[K]{}
+}
+
+// KeysBetween returns a slice of all keys in the OrderedMap between the bounds lo and hi,
+// in ascending order.
+func (t *OrderedMap[K, V]) KeysBetween(lo, hi Bound[K]) []K {
+	keys := make([]K, 0)
+	t.ascend(t.between(lo, hi), func(x *node[K, V]) bool {
+		keys = append(keys, x.key)
+		return true
+	})
+	return keys
+}
+
+// CountBetween returns the number of keys in the OrderedMap between the bounds lo and hi.
+// It runs in O(log n) and does not allocate.
+func (t *OrderedMap[K, V]) CountBetween(lo, hi Bound[K]) int {
+	n := t.rankUpper(hi) - t.rankLower(lo)
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// RangeBetween returns an iterator over the key-value pairs of the OrderedMap with keys
+// between the bounds lo and hi, in ascending key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) RangeBetween(lo, hi Bound[K]) iter.Seq2[K, V] {
+	pos := t.between(lo, hi)
+	return func(yield func(K, V) bool) {
+		t.ascend(pos, func(x *node[K, V]) bool {
+			return yield(x.key, x.val)
+		})
+	}
+}
+
+// BackwardBetween returns an iterator over the key-value pairs of the OrderedMap with
+// keys between the bounds lo and hi, in descending key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) BackwardBetween(lo, hi Bound[K]) iter.Seq2[K, V] {
+	pos := t.between(lo, hi)
+	return func(yield func(K, V) bool) {
+		t.descend(pos, func(x *node[K, V]) bool {
+			return yield(x.key, x.val)
+		})
+	}
+}
+
+// RangeFunc returns an iterator over the key-value pairs of the OrderedMap whose keys
+// lie in the range described by pos, in ascending key order. pos reports whether a
+// key is below (< 0), inside (0) or above (> 0) the range, in the same way as the
+// cmp argument of slices.BinarySearchFunc, and must be monotone in the key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) RangeFunc(pos func(K) int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(pos, func(x *node[K, V]) bool {
+			return yield(x.key, x.val)
+		})
+	}
+}
+
+// CountFunc returns the number of keys in the OrderedMap that lie in the range
+// described by pos. See RangeFunc for pos. It runs in O(log n).
+func (t *OrderedMap[K, V]) CountFunc(pos func(K) int) int {
+	return t.rankFunc(pos, true) - t.rankFunc(pos, false)
+}
+
+// between returns the position function used by ascend and descend for the range
+// between the bounds lo and hi.
+func (t *OrderedMap[K, V]) between(lo, hi Bound[K]) func(K) int {
+	if lo.kind == unbounded && hi.kind == unbounded {
+		return nil
+	}
+	return func(key K) int {
+		switch {
+		case t.belowLower(key, lo):
+			return -1
+		case t.aboveUpper(key, hi):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// belowLower reports whether key lies below the lower bound lo.
+func (t *OrderedMap[K, V]) belowLower(key K, lo Bound[K]) bool {
+	switch lo.kind {
+	case inclusive:
+		return t.compare(key, lo.key) < 0
+	case exclusive:
+		return t.compare(key, lo.key) <= 0
+	default:
+		return false
+	}
+}
+
+// aboveUpper reports whether key lies above the upper bound hi.
+func (t *OrderedMap[K, V]) aboveUpper(key K, hi Bound[K]) bool {
+	switch hi.kind {
+	case inclusive:
+		return t.compare(key, hi.key) > 0
+	case exclusive:
+		return t.compare(key, hi.key) >= 0
+	default:
+		return false
+	}
+}
+
+// rankLower returns the number of keys below the lower bound lo.
+func (t *OrderedMap[K, V]) rankLower(lo Bound[K]) int {
+	switch lo.kind {
+	case inclusive:
+		return t.rank(t.root, lo.key, false)
+	case exclusive:
+		return t.rank(t.root, lo.key, true)
+	default:
+		return 0
+	}
+}
+
+// rankUpper returns the number of keys that do not lie above the upper bound hi.
+func (t *OrderedMap[K, V]) rankUpper(hi Bound[K]) int {
+	switch hi.kind {
+	case inclusive:
+		return t.rank(t.root, hi.key, true)
+	case exclusive:
+		return t.rank(t.root, hi.key, false)
+	default:
+		return t.Size()
+	}
+}
+
+// rankFunc returns the number of keys below the range described by pos.
+// If inclusive is true, the keys inside the range are counted as well.
+func (t *OrderedMap[K, V]) rankFunc(pos func(K) int, inclusive bool) int {
+	r := 0
+	for x := t.root; x != nil; {
+		c := pos(x.key)
+		if c < 0 || (c == 0 && inclusive) {
+			r += 1 + t.size(x.left)
+			x = x.right
+		} else {
+			x = x.left
+		}
+	}
+	return r
+}
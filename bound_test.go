@@ -0,0 +1,102 @@
+package orderedmap
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// randomBound returns an inclusive, exclusive or unbounded Bound with a key in [-50, 1050).
+func randomBound(rng *rand.Rand) Bound[int] {
+	switch rng.Intn(5) {
+	case 0:
+		return Unbounded[int]()
+	case 1, 2:
+		return Inclusive(rng.Intn(1100) - 50)
+	default:
+		return Exclusive(rng.Intn(1100) - 50)
+	}
+}
+
+// inBounds reports whether k lies between lo and hi, computed directly from the bound fields.
+func inBounds(k int, lo, hi Bound[int]) bool {
+	switch lo.kind {
+	case inclusive:
+		if k < lo.key {
+			return false
+		}
+	case exclusive:
+		if k <= lo.key {
+			return false
+		}
+	}
+	switch hi.kind {
+	case inclusive:
+		if k > hi.key {
+			return false
+		}
+	case exclusive:
+		if k >= hi.key {
+			return false
+		}
+	}
+	return true
+}
+
+// TestBetween tests KeysBetween, CountBetween, RangeBetween and BackwardBetween with random
+// combinations of inclusive, exclusive and unbounded ends against a linear
+// filter of Keys.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestBetween(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 300; i++ {
+		k := rng.Intn(1000)
+		om.Put(k, k*2)
+	}
+	all := om.Keys()
+
+	for i := 0; i < 2000; i++ {
+		lo, hi := randomBound(rng), randomBound(rng)
+		name := fmt.Sprintf("(%+v, %+v)", lo, hi)
+
+		want := []int{}
+		for _, k := range all {
+			if inBounds(k, lo, hi) {
+				want = append(want, k)
+			}
+		}
+
+		if got := om.KeysBetween(lo, hi); !slices.Equal(got, want) {
+			t.Errorf("KeysBetween%s: expected %v, got %v", name, want, got)
+		}
+		if got := om.CountBetween(lo, hi); got != len(want) {
+			t.Errorf("CountBetween%s: expected %d, got %d", name, len(want), got)
+		}
+		got := []int{}
+		for k, v := range om.RangeBetween(lo, hi) {
+			if v != k*2 {
+				t.Errorf("RangeBetween%s: wrong value %d for key %d", name, v, k)
+			}
+			got = append(got, k)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("RangeBetween%s: expected %v, got %v", name, want, got)
+		}
+
+		got = got[:0]
+		for k := range om.BackwardBetween(lo, hi) {
+			got = append(got, k)
+		}
+		slices.Reverse(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("BackwardBetween%s: expected reversed %v, got %v", name, want, got)
+		}
+	}
+}
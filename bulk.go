@@ -0,0 +1,145 @@
+package orderedmap
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrUnsorted is returned by the NewOrderedMapFromSorted constructors when the
+// input keys are not strictly increasing, that is unsorted or duplicated.
+var ErrUnsorted = errors.New("orderedmap: keys not strictly increasing")
+
+// NewOrderedMapFromSorted creates an OrderedMap from entries whose keys are strictly
+// increasing in their natural order. The balanced tree is built directly in O(n)
+// without any rotations. It returns ErrUnsorted if a key is not greater than the
+// key before it.
+func NewOrderedMapFromSorted[K constraints.Ordered, V any](entries []Entry[K, V]) (*OrderedMap[K, V], error) {
+	t := NewOrderedMap[K, V]()
+	if err := t.loadSorted(entries); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NewOrderedMapFromSortedFunc is like NewOrderedMapFromSorted but orders keys by
+// cmp, as NewOrderedMapFunc does.
+func NewOrderedMapFromSortedFunc[K, V any](cmp func(a, b K) int, entries []Entry[K, V]) (*OrderedMap[K, V], error) {
+	t := NewOrderedMapFunc[K, V](cmp)
+	if err := t.loadSorted(entries); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NewOrderedMapFromSortedSeq is like NewOrderedMapFromSorted but reads the entries
+// from seq. It stops reading at the first key that is out of order.
+func NewOrderedMapFromSortedSeq[K constraints.Ordered, V any](seq iter.Seq2[K, V]) (*OrderedMap[K, V], error) {
+	t := NewOrderedMap[K, V]()
+	if err := t.loadSortedSeq(seq); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NewOrderedMapFromSortedSeqFunc is like NewOrderedMapFromSortedSeq but orders keys
+// by cmp, as NewOrderedMapFunc does.
+func NewOrderedMapFromSortedSeqFunc[K, V any](cmp func(a, b K) int, seq iter.Seq2[K, V]) (*OrderedMap[K, V], error) {
+	t := NewOrderedMapFunc[K, V](cmp)
+	if err := t.loadSortedSeq(seq); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// loadSorted replaces the contents of the map with entries, which must be strictly
+// increasing.
+func (t *OrderedMap[K, V]) loadSorted(entries []Entry[K, V]) error {
+	t.root = nil
+	for i := 1; i < len(entries); i++ {
+		if t.compare(entries[i-1].Key, entries[i].Key) >= 0 {
+			return fmt.Errorf("%w: key %v at index %d", ErrUnsorted, entries[i].Key, i)
+		}
+	}
+	t.root = t.build(entries, bits.Len(uint(len(entries)+1))-1, true)
+	return nil
+}
+
+// loadSortedSeq replaces the contents of the map with the entries of seq, which
+// must be strictly increasing.
+func (t *OrderedMap[K, V]) loadSortedSeq(seq iter.Seq2[K, V]) error {
+	var entries []Entry[K, V]
+	var err error
+	for k, v := range seq {
+		if n := len(entries); n > 0 && t.compare(entries[n-1].Key, k) >= 0 {
+			err = fmt.Errorf("%w: key %v at index %d", ErrUnsorted, k, n)
+			break
+		}
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	if err != nil {
+		t.root = nil
+		return err
+	}
+	t.root = t.build(entries, bits.Len(uint(len(entries)+1))-1, true)
+	return nil
+}
+
+// build returns a left-leaning red-black tree of black height bh holding the sorted
+// entries. The tree is laid out as a 2-3 tree: every node is a 2-node, a black node
+// with two subtrees, unless the entries do not fit below it, in which case it is a
+// 3-node, a black node with a red left child and three subtrees. A tree of black
+// height bh holds between 2^bh-1 and 3^bh-1 entries, and bh = floor(log2(n+1))
+// keeps n in that range at every level. The keys are copied with copyKey only if
+// copyKeys is set; callers that merge in keys the map already owns copy the new
+// keys themselves.
+func (t *OrderedMap[K, V]) build(entries []Entry[K, V], bh int, copyKeys bool) *node[K, V] {
+	n := len(entries)
+	if n == 0 {
+		return nil
+	}
+	newNode := t.leaf
+	if copyKeys {
+		newNode = t.newNode
+	}
+
+	if mid := (n - 1) / 2; n-1-mid <= maxKeys(bh-1) {
+		h := newNode(entries[mid].Key, entries[mid].Value)
+		h.color = BLACK
+		h.left = t.build(entries[:mid], bh-1, copyKeys)
+		h.right = t.build(entries[mid+1:], bh-1, copyKeys)
+		h.size = n
+		return h
+	}
+
+	a := (n - 2) / 3
+	b := (n - 2 - a) / 2
+	m := newNode(entries[a].Key, entries[a].Value)
+	m.left = t.build(entries[:a], bh-1, copyKeys)
+	m.right = t.build(entries[a+1:a+1+b], bh-1, copyKeys)
+	m.size = a + b + 1
+
+	h := newNode(entries[a+1+b].Key, entries[a+1+b].Value)
+	h.color = BLACK
+	h.left = m
+	h.right = t.build(entries[a+2+b:], bh-1, copyKeys)
+	h.size = n
+	return h
+}
+
+// maxKeys returns the largest number of entries a tree of black height bh can
+// hold, 3^bh-1, saturating at math.MaxInt.
+func maxKeys(bh int) int {
+	n := 1
+	for ; bh > 0; bh-- {
+		if n > math.MaxInt/3 {
+			return math.MaxInt
+		}
+		n *= 3
+	}
+	return n - 1
+}
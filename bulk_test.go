@@ -0,0 +1,104 @@
+package orderedmap
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestNewOrderedMapFromSorted tests bulk loading sorted entries of every size up to
+// 1000 and one large input, checking that each tree is a valid red-black tree with
+// the expected contents that can still be modified afterwards.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestNewOrderedMapFromSorted(t *testing.T) {
+	sizes := []int{200000}
+	for n := 0; n <= 1000; n++ {
+		sizes = append(sizes, n)
+	}
+	for _, n := range sizes {
+		entries := make([]Entry[int, int], n)
+		for i := range entries {
+			entries[i] = Entry[int, int]{Key: i * 2, Value: i}
+		}
+		om, err := NewOrderedMapFromSorted(entries)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		if err := om.Validate(); err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		if om.Size() != n {
+			t.Fatalf("n=%d: expected size %d, got %d", n, n, om.Size())
+		}
+		if n > 0 {
+			if _, v, _ := om.Select(n / 2); v != n/2 {
+				t.Fatalf("n=%d: Select(%d) returned %d", n, n/2, v)
+			}
+			om.Put(1, -1)
+			om.Delete(0)
+			if err := om.Validate(); err != nil {
+				t.Fatalf("n=%d after Put/Delete: %v", n, err)
+			}
+		}
+	}
+}
+
+// TestNewOrderedMapFromSortedRejects tests that unsorted and duplicate input is
+// rejected with ErrUnsorted by the slice and iterator constructors, and that the
+// Func variants use the given ordering.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestNewOrderedMapFromSortedRejects(t *testing.T) {
+	for _, keys := range [][]string{{"a", "c", "b"}, {"a", "b", "b"}} {
+		entries := make([]Entry[string, int], len(keys))
+		m := make(map[string]int)
+		for i, k := range keys {
+			entries[i] = Entry[string, int]{Key: k}
+			m[k] = i
+		}
+		if om, err := NewOrderedMapFromSorted(entries); !errors.Is(err, ErrUnsorted) || om != nil {
+			t.Errorf("%q: expected ErrUnsorted, got %v", keys, err)
+		}
+		seq := func(yield func(string, int) bool) {
+			for i, k := range keys {
+				if !yield(k, i) {
+					return
+				}
+			}
+		}
+		if _, err := NewOrderedMapFromSortedSeq(seq); !errors.Is(err, ErrUnsorted) {
+			t.Errorf("%q: expected ErrUnsorted from the iterator constructor, got %v", keys, err)
+		}
+	}
+
+	desc := func(a, b string) int { return strings.Compare(b, a) }
+	entries := []Entry[string, int]{{"c", 3}, {"b", 2}, {"a", 1}}
+	om, err := NewOrderedMapFromSortedFunc(desc, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := om.Keys(); !slices.Equal(got, []string{"c", "b", "a"}) {
+		t.Errorf("NewOrderedMapFromSortedFunc: unexpected keys %q", got)
+	}
+
+	src := NewOrderedMapFunc[string, int](desc)
+	for _, e := range entries {
+		src.Put(e.Key, e.Value)
+	}
+	om, err = NewOrderedMapFromSortedSeqFunc(desc, src.All())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := om.Validate(); err != nil || !maps.Equal(maps.Collect(om.All()), map[string]int{"a": 1, "b": 2, "c": 3}) {
+		t.Errorf("NewOrderedMapFromSortedSeqFunc: unexpected map %v (%v)", maps.Collect(om.All()), err)
+	}
+}
@@ -0,0 +1,31 @@
+package orderedmap
+
+// Clone returns a copy of the OrderedMap with the same ordering. The node tree is
+// copied as it is, shape, colors and sizes included, so Clone runs in O(n) without
+// comparing keys or rebalancing. Values are copied by assignment; use CloneFunc
+// for values that hold pointers.
+func (t *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	return t.CloneFunc(nil)
+}
+
+// CloneFunc is like Clone but stores copyValue(v) for every value v. A nil
+// copyValue copies values by assignment.
+func (t *OrderedMap[K, V]) CloneFunc(copyValue func(V) V) *OrderedMap[K, V] {
+	c := t.empty()
+	c.root = cloneNode(t.root, copyValue)
+	return c
+}
+
+// cloneNode returns a copy of the subtree rooted at x.
+func cloneNode[K, V any](x *node[K, V], copyValue func(V) V) *node[K, V] {
+	if x == nil {
+		return nil
+	}
+	c := *x
+	if copyValue != nil {
+		c.val = copyValue(x.val)
+	}
+	c.left = cloneNode(x.left, copyValue)
+	c.right = cloneNode(x.right, copyValue)
+	return &c
+}
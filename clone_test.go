@@ -0,0 +1,54 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestClone tests that Clone and CloneFunc copy the tree exactly and that the copy
+// and the original can be changed independently afterwards.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestClone(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	om := NewOrderedMap[int, []int]()
+	for i := 0; i < 1000; i++ {
+		k := rng.Intn(5000)
+		om.Put(k, []int{k})
+	}
+	keys := om.Keys()
+
+	c := om.Clone()
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(c.Keys(), keys) || om.blackHeight(om.root) != c.blackHeight(c.root) {
+		t.Fatal("Clone: copy differs from original")
+	}
+	for _, k := range keys[:len(keys)/2] {
+		c.Delete(k)
+	}
+	c.Put(-1, nil)
+	if err := om.Validate(); err != nil || !slices.Equal(om.Keys(), keys) {
+		t.Fatalf("Clone: changing the copy changed the original: %v", err)
+	}
+
+	d := om.CloneFunc(slices.Clone[[]int])
+	for v := range d.AllValues() {
+		v[0] = -1
+	}
+	for k, v := range om.All() {
+		if v[0] != k {
+			t.Fatalf("CloneFunc: value of key %d shared with the copy", k)
+		}
+	}
+
+	if e := NewOrderedMap[int, int]().Clone(); !e.IsEmpty() || e.Validate() != nil {
+		t.Error("Clone of an empty map is not empty")
+	}
+}
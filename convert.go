@@ -0,0 +1,147 @@
+package orderedmap
+
+import (
+	"iter"
+	"math/bits"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// FromMap creates an OrderedMap holding the entries of the built-in map m. The keys
+// are sorted once and the tree is bulk built, which is faster than a Put per key.
+func FromMap[K constraints.Ordered, V any](m map[K]V) *OrderedMap[K, V] {
+	t := NewOrderedMap[K, V]()
+	t.putSorted(t.sortEntries(mapEntries(m)))
+	return t
+}
+
+// FromMapFunc is like FromMap but orders keys by cmp, as NewOrderedMapFunc does.
+func FromMapFunc[K comparable, V any](cmp func(a, b K) int, m map[K]V) *OrderedMap[K, V] {
+	t := NewOrderedMapFunc[K, V](cmp)
+	t.putSorted(t.sortEntries(mapEntries(m)))
+	return t
+}
+
+// Collect creates an OrderedMap holding the key-value pairs of seq. If a key
+// occurs more than once, the last value wins, as with repeated calls to Put.
+func Collect[K constraints.Ordered, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	t := NewOrderedMap[K, V]()
+	t.Insert(seq)
+	return t
+}
+
+// CollectFunc is like Collect but orders keys by cmp, as NewOrderedMapFunc does.
+func CollectFunc[K, V any](cmp func(a, b K) int, seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	t := NewOrderedMapFunc[K, V](cmp)
+	t.Insert(seq)
+	return t
+}
+
+// ToMap returns a built-in map holding the entries of t.
+func ToMap[K comparable, V any](t *OrderedMap[K, V]) map[K]V {
+	m := make(map[K]V, t.Size())
+	for k, v := range t.All() {
+		m[k] = v
+	}
+	return m
+}
+
+// Insert adds the key-value pairs of seq to the OrderedMap, overwriting the values
+// of existing keys. If a key occurs more than once, the last value wins.
+// The pairs are sorted first, and large batches are merged with the existing
+// entries and bulk built instead of being put one by one.
+func (t *OrderedMap[K, V]) Insert(seq iter.Seq2[K, V]) {
+	var entries []Entry[K, V]
+	for k, v := range seq {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	t.putSorted(t.sortEntries(entries))
+}
+
+// PutAll adds the entries of other to the OrderedMap, overwriting the values of
+// existing keys. The entries of other are already sorted, so large batches are
+// merged and bulk built in O(n+m) instead of being put one by one.
+func (t *OrderedMap[K, V]) PutAll(other *OrderedMap[K, V]) {
+	if other == t || other.IsEmpty() {
+		return
+	}
+	entries := other.AppendEntriesBetween(make([]Entry[K, V], 0, other.Size()), Unbounded[K](), Unbounded[K]())
+	// other may be ordered differently from t
+	t.putSorted(t.sortEntries(entries))
+}
+
+// mapEntries returns the entries of m in unspecified order.
+func mapEntries[K comparable, V any](m map[K]V) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+// sortEntries sorts entries by key and removes duplicate keys, keeping the last
+// value for each key. Entries that are already strictly increasing are returned
+// as they are.
+func (t *OrderedMap[K, V]) sortEntries(entries []Entry[K, V]) []Entry[K, V] {
+	compare := func(a, b Entry[K, V]) int { return t.compare(a.Key, b.Key) }
+	strict := true
+	for i := 1; i < len(entries) && strict; i++ {
+		strict = compare(entries[i-1], entries[i]) < 0
+	}
+	if strict {
+		return entries
+	}
+
+	slices.SortStableFunc(entries, compare)
+	out := entries[:0]
+	for _, e := range entries {
+		if n := len(out); n > 0 && compare(out[n-1], e) == 0 {
+			out[n-1] = e
+		} else {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// putSorted adds strictly increasing entries to the map. An empty map is bulk
+// built directly. Otherwise the entries are put one by one when that is cheaper,
+// that is m*log(n) < n+m, and merged with the existing entries and bulk built
+// when it is not.
+func (t *OrderedMap[K, V]) putSorted(entries []Entry[K, V]) {
+	n, m := t.Size(), len(entries)
+	switch {
+	case m == 0:
+		return
+	case n == 0:
+		t.root = t.build(entries, bits.Len(uint(m+1))-1, true)
+		return
+	case m*bits.Len(uint(n)) < n+m:
+		for _, e := range entries {
+			t.Put(e.Key, e.Value)
+		}
+		return
+	}
+
+	merged := make([]Entry[K, V], 0, n+m)
+	i := 0
+	t.ascend(nil, func(x *node[K, V]) bool {
+		for i < m && t.compare(entries[i].Key, x.key) < 0 {
+			merged = append(merged, Entry[K, V]{Key: t.ownKey(entries[i].Key), Value: entries[i].Value})
+			i++
+		}
+		if i < m && t.compare(entries[i].Key, x.key) == 0 {
+			merged = append(merged, Entry[K, V]{Key: x.key, Value: entries[i].Value})
+			i++
+		} else {
+			merged = append(merged, Entry[K, V]{Key: x.key, Value: x.val})
+		}
+		return true
+	})
+	for _, e := range entries[i:] {
+		merged = append(merged, Entry[K, V]{Key: t.ownKey(e.Key), Value: e.Value})
+	}
+	// the keys of the map are reused and only the new keys were copied above
+	t.root = t.build(merged, bits.Len(uint(len(merged)+1))-1, false)
+}
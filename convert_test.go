@@ -0,0 +1,157 @@
+package orderedmap
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestFromMapAndToMap tests the round trip from a built-in map to an OrderedMap
+// and back, and that the bulk built tree is valid.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestFromMapAndToMap(t *testing.T) {
+	m := uniqueValues(10000)
+	om := FromMap(m)
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if om.Size() != len(m) || !slices.IsSorted(om.Keys()) {
+		t.Fatalf("FromMap: expected %d sorted keys, got %d", len(m), om.Size())
+	}
+	if back := ToMap(om); !maps.Equal(back, m) {
+		t.Error("ToMap(FromMap(m)) differs from m")
+	}
+
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	om = FromMapFunc(byLength, map[string]int{"a": 1, "bbb": 3, "cc": 2})
+	if got := om.Keys(); !slices.Equal(got, []string{"a", "cc", "bbb"}) {
+		t.Errorf("FromMapFunc: unexpected keys %q", got)
+	}
+}
+
+// TestCollectAndInsert tests Collect and Insert with duplicate keys, where the last
+// value must win, and Insert into maps of different sizes, which exercises both the
+// one-by-one and the merge paths.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestCollectAndInsert(t *testing.T) {
+	seq := func(yield func(int, string) bool) {
+		for _, k := range []int{3, 1, 2, 3, 1} {
+			if !yield(k, string(rune('a'+k))) {
+				return
+			}
+		}
+		yield(1, "last")
+	}
+	om := Collect(seq)
+	if got := ToMap(om); !maps.Equal(got, map[int]string{1: "last", 2: "c", 3: "d"}) {
+		t.Errorf("Collect: unexpected map %v", got)
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, sizes := range [][2]int{{0, 500}, {1000, 5}, {1000, 800}, {50, 5000}} {
+		om := NewOrderedMap[int, int]()
+		ref := make(map[int]int)
+		for i := 0; i < sizes[0]; i++ {
+			k := rng.Intn(10000)
+			om.Put(k, i)
+			ref[k] = i
+		}
+		batch := make(map[int]int)
+		for i := 0; i < sizes[1]; i++ {
+			k := rng.Intn(10000)
+			batch[k] = -i
+			ref[k] = -i
+		}
+		om.Insert(maps.All(batch))
+		if err := om.Validate(); err != nil {
+			t.Fatalf("Insert %v: %v", sizes, err)
+		}
+		if got := ToMap(om); !maps.Equal(got, ref) {
+			t.Errorf("Insert %v: map differs from reference", sizes)
+		}
+	}
+
+	desc := CollectFunc(func(a, b int) int { return b - a }, maps.All(map[int]bool{1: true, 3: true, 2: true}))
+	if got := desc.Keys(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("CollectFunc: unexpected keys %v", got)
+	}
+}
+
+// TestPutAll tests PutAll between maps of different sizes and between maps with
+// different orderings, checking that values of other overwrite existing ones, and
+// that merging into a map that copies its keys copies only the new keys.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestPutAll(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, sizes := range [][2]int{{0, 300}, {2000, 3}, {500, 500}} {
+		a, b := NewOrderedMap[int, int](), NewOrderedMap[int, int]()
+		ref := make(map[int]int)
+		for i := 0; i < sizes[0]; i++ {
+			k := rng.Intn(3000)
+			a.Put(k, i)
+			ref[k] = i
+		}
+		for i := 0; i < sizes[1]; i++ {
+			k := rng.Intn(3000)
+			b.Put(k, -i)
+			ref[k] = -i
+		}
+		a.PutAll(b)
+		if err := a.Validate(); err != nil {
+			t.Fatalf("PutAll %v: %v", sizes, err)
+		}
+		if got := ToMap(a); !maps.Equal(got, ref) {
+			t.Errorf("PutAll %v: map differs from reference", sizes)
+		}
+	}
+
+	asc := NewOrderedMap[int, int]()
+	desc := NewOrderedMapFunc[int, int](func(a, b int) int { return b - a })
+	for i := 0; i < 10; i++ {
+		desc.Put(i, i)
+	}
+	asc.PutAll(desc)
+	asc.PutAll(asc)
+	if err := asc.Validate(); err != nil || asc.Size() != 10 {
+		t.Errorf("PutAll from a differently ordered map: size %d, %v", asc.Size(), err)
+	}
+
+	copies := 0
+	bm, other := NewBytesMap[int](), NewBytesMap[int]()
+	bm.copyKey = func(k []byte) []byte {
+		copies++
+		return slices.Clone(k)
+	}
+	for i := 0; i < 1000; i += 2 {
+		bm.Put(fmt.Appendf(nil, "%04d", i), i)
+	}
+	for i := 0; i < 1000; i += 3 {
+		other.Put(fmt.Appendf(nil, "%04d", i), -i)
+	}
+	copies = 0
+	bm.PutAll(other)
+	if err := bm.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if added := bm.Size() - 500; copies != added {
+		t.Errorf("PutAll into a bytes map: expected %d key copies, got %d", added, copies)
+	}
+	if v, found := bm.Get([]byte("0003")); !found || v != -3 {
+		t.Errorf("PutAll into a bytes map: Get(0003) returned %d, %v", v, found)
+	}
+}
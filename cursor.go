@@ -0,0 +1,173 @@
+package orderedmap
+
+// Cursor is a stateful, bidirectional position in an OrderedMap. It keeps the
+// path from the root to the current node, so Next and Prev run in amortized O(1)
+// and a cursor can be kept across function calls to resume a scan.
+//
+// A cursor is invalidated by any Put or Delete on its map; call Seek again to
+// reposition it. SetValue does not change the tree structure and is allowed.
+type Cursor[K, V any] struct {
+	t    *OrderedMap[K, V]
+	path []*node[K, V]
+}
+
+// Cursor returns a new cursor on the OrderedMap. The cursor is not positioned on
+// any entry until First, Last or Seek is called.
+func (t *OrderedMap[K, V]) Cursor() *Cursor[K, V] {
+	return &Cursor[K, V]{t: t}
+}
+
+// Valid reports whether the cursor is positioned on an entry.
+func (c *Cursor[K, V]) Valid() bool {
+	return len(c.path) > 0
+}
+
+// Key returns the key of the current entry, or the zero value of K if the
+// cursor is not valid.
+func (c *Cursor[K, V]) Key() K {
+	if !c.Valid() {
+		var zero K
+		return zero
+	}
+	return c.current().key
+}
+
+// Value returns the value of the current entry, or the zero value of V if the
+// cursor is not valid.
+func (c *Cursor[K, V]) Value() V {
+	if !c.Valid() {
+		var zero V
+		return zero
+	}
+	return c.current().val
+}
+
+// SetValue replaces the value of the current entry in place.
+// It returns false if the cursor is not valid.
+func (c *Cursor[K, V]) SetValue(val V) bool {
+	if !c.Valid() {
+		return false
+	}
+	c.current().val = val
+	return true
+}
+
+// First moves the cursor to the smallest key and reports whether the map is non-empty.
+func (c *Cursor[K, V]) First() bool {
+	c.path = c.path[:0]
+	c.pushLeft(c.t.root)
+	return c.Valid()
+}
+
+// Last moves the cursor to the largest key and reports whether the map is non-empty.
+func (c *Cursor[K, V]) Last() bool {
+	c.path = c.path[:0]
+	c.pushRight(c.t.root)
+	return c.Valid()
+}
+
+// Seek moves the cursor to the smallest key greater than or equal to key,
+// the ceiling of key. It reports whether such a key exists.
+func (c *Cursor[K, V]) Seek(key K) bool {
+	c.path = c.path[:0]
+	ceil := 0
+	for x := c.t.root; x != nil; {
+		c.path = append(c.path, x)
+		switch d := c.t.compare(key, x.key); {
+		case d < 0:
+			ceil = len(c.path)
+			x = x.left
+		case d > 0:
+			x = x.right
+		default:
+			return true
+		}
+	}
+	// the ceiling is the last node on the path where the search turned left
+	c.path = c.path[:ceil]
+	return c.Valid()
+}
+
+// seekRank moves the cursor to the entry with rank i, the i-th smallest key
+// counting from zero, and reports whether such an entry exists.
+func (c *Cursor[K, V]) seekRank(i int) bool {
+	c.path = c.path[:0]
+	if i < 0 || i >= c.t.Size() {
+		return false
+	}
+	for x := c.t.root; x != nil; {
+		c.path = append(c.path, x)
+		leftSize := c.t.size(x.left)
+		switch {
+		case i < leftSize:
+			x = x.left
+		case i > leftSize:
+			i -= leftSize + 1
+			x = x.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Next moves the cursor to the next larger key. It reports false, leaving the
+// cursor invalid, if the cursor was on the last entry or was not valid.
+func (c *Cursor[K, V]) Next() bool {
+	if !c.Valid() {
+		return false
+	}
+	x := c.current()
+	if x.right != nil {
+		c.pushLeft(x.right)
+		return true
+	}
+	// climb until we leave a left subtree
+	for {
+		c.path = c.path[:len(c.path)-1]
+		if !c.Valid() || c.current().left == x {
+			return c.Valid()
+		}
+		x = c.current()
+	}
+}
+
+// Prev moves the cursor to the next smaller key. It reports false, leaving the
+// cursor invalid, if the cursor was on the first entry or was not valid.
+func (c *Cursor[K, V]) Prev() bool {
+	if !c.Valid() {
+		return false
+	}
+	x := c.current()
+	if x.left != nil {
+		c.pushRight(x.left)
+		return true
+	}
+	// climb until we leave a right subtree
+	for {
+		c.path = c.path[:len(c.path)-1]
+		if !c.Valid() || c.current().right == x {
+			return c.Valid()
+		}
+		x = c.current()
+	}
+}
+
+// current returns the node the cursor is positioned on.
+func (c *Cursor[K, V]) current() *node[K, V] {
+	return c.path[len(c.path)-1]
+}
+
+// pushLeft appends x and its chain of left children to the path.
+func (c *Cursor[K, V]) pushLeft(x *node[K, V]) {
+	for ; x != nil; x = x.left {
+		c.path = append(c.path, x)
+	}
+}
+
+// pushRight appends x and its chain of right children to the path.
+func (c *Cursor[K, V]) pushRight(x *node[K, V]) {
+	for ; x != nil; x = x.right {
+		c.path = append(c.path, x)
+	}
+}
@@ -0,0 +1,103 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestCursorWalk tests walking a Cursor forward from First and backward from Last,
+// comparing the visited keys with the slice returned by Keys.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestCursorWalk(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 500; i++ {
+		om.Put(rng.Intn(2000), i)
+	}
+	keys := om.Keys()
+
+	c := om.Cursor()
+	i := 0
+	for ok := c.First(); ok; ok = c.Next() {
+		if c.Key() != keys[i] {
+			t.Fatalf("Next: expected key %d at %d, got %d", keys[i], i, c.Key())
+		}
+		i++
+	}
+	if i != len(keys) {
+		t.Errorf("Next: expected %d keys, got %d", len(keys), i)
+	}
+	if c.Valid() || c.Next() || c.Prev() {
+		t.Error("cursor should be invalid after walking past the end")
+	}
+
+	i = len(keys) - 1
+	for ok := c.Last(); ok; ok = c.Prev() {
+		if c.Key() != keys[i] {
+			t.Fatalf("Prev: expected key %d at %d, got %d", keys[i], i, c.Key())
+		}
+		i--
+	}
+	if i != -1 {
+		t.Errorf("Prev: stopped early at %d", i)
+	}
+
+	empty := NewOrderedMap[int, int]().Cursor()
+	if empty.First() || empty.Last() || empty.Seek(0) {
+		t.Error("cursor on an empty map should not be valid")
+	}
+}
+
+// TestCursorSeekAndSetValue tests that Seek lands on the ceiling of a key, that
+// the cursor can change direction after a Seek, and that SetValue updates the
+// map in place.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestCursorSeekAndSetValue(t *testing.T) {
+	om := NewOrderedMap[int, string]()
+	for i := 0; i < 100; i += 10 {
+		om.Put(i, "")
+	}
+
+	c := om.Cursor()
+	for key := -5; key < 100; key++ {
+		want, _, found := om.Ceiling(key)
+		if c.Seek(key) != found {
+			t.Fatalf("Seek(%d): expected %v", key, found)
+		}
+		if found && c.Key() != want {
+			t.Errorf("Seek(%d): expected %d, got %d", key, want, c.Key())
+		}
+	}
+	if c.Seek(91) || c.Valid() {
+		t.Error("Seek past the last key should leave the cursor invalid")
+	}
+
+	c.Seek(45)
+	c.Prev()
+	if c.Key() != 40 {
+		t.Errorf("Prev after Seek(45): expected 40, got %d", c.Key())
+	}
+	c.Next()
+	c.Next()
+	if c.Key() != 60 {
+		t.Errorf("Next twice: expected 60, got %d", c.Key())
+	}
+
+	for ok := c.First(); ok; ok = c.Next() {
+		c.SetValue("v" + c.Value())
+	}
+	for k, v := range om.All() {
+		if v != "v" {
+			t.Errorf("SetValue: expected %q for key %d, got %q", "v", k, v)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package orderedmap
+
+// Entry is a key-value pair stored in an OrderedMap.
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// EntriesInRange returns a slice of all key-value pairs in the OrderedMap with keys
+// between lo and hi, inclusive, in ascending key order.
+func (t *OrderedMap[K, V]) EntriesInRange(lo, hi K) []Entry[K, V] {
+	return t.AppendEntriesBetween(make([]Entry[K, V], 0), Inclusive(lo), Inclusive(hi))
+}
+
+// ValuesInRange returns a slice of the values in the OrderedMap whose keys lie
+// between lo and hi, inclusive, in ascending key order.
+func (t *OrderedMap[K, V]) ValuesInRange(lo, hi K) []V {
+	return t.ValuesBetween(Inclusive(lo), Inclusive(hi))
+}
+
+// AppendEntriesInRange appends the key-value pairs with keys between lo and hi,
+// inclusive, to dst in ascending key order and returns the extended slice.
+func (t *OrderedMap[K, V]) AppendEntriesInRange(dst []Entry[K, V], lo, hi K) []Entry[K, V] {
+	return t.AppendEntriesBetween(dst, Inclusive(lo), Inclusive(hi))
+}
+
+// EntriesBetween returns a slice of all key-value pairs in the OrderedMap with keys
+// between the bounds lo and hi, in ascending key order.
+func (t *OrderedMap[K, V]) EntriesBetween(lo, hi Bound[K]) []Entry[K, V] {
+	return t.AppendEntriesBetween(make([]Entry[K, V], 0), lo, hi)
+}
+
+// ValuesBetween returns a slice of the values in the OrderedMap whose keys lie
+// between the bounds lo and hi, in ascending key order.
+func (t *OrderedMap[K, V]) ValuesBetween(lo, hi Bound[K]) []V {
+	values := make([]V, 0)
+	t.ascend(t.between(lo, hi), func(x *node[K, V]) bool {
+		values = append(values, x.val)
+		return true
+	})
+	return values
+}
+
+// AppendEntriesBetween appends the key-value pairs with keys between the bounds
+// lo and hi to dst in ascending key order and returns the extended slice.
+// Passing dst[:0] lets a caller reuse the same buffer across calls.
+func (t *OrderedMap[K, V]) AppendEntriesBetween(dst []Entry[K, V], lo, hi Bound[K]) []Entry[K, V] {
+	t.ascend(t.between(lo, hi), func(x *node[K, V]) bool {
+		dst = append(dst, Entry[K, V]{Key: x.key, Value: x.val})
+		return true
+	})
+	return dst
+}
+
+// LastN returns up to n key-value pairs with the largest keys in the OrderedMap,
+// in descending key order. Only the visited part of the tree is walked.
+func (t *OrderedMap[K, V]) LastN(n int) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, min(max(n, 0), t.Size()))
+	if n <= 0 {
+		return entries
+	}
+	t.descend(nil, func(x *node[K, V]) bool {
+		entries = append(entries, Entry[K, V]{Key: x.key, Value: x.val})
+		return len(entries) < n
+	})
+	return entries
+}
@@ -0,0 +1,50 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestEntriesInRange tests EntriesInRange, ValuesInRange and AppendEntriesInRange
+// against KeysInRange and Get, including reuse of a caller supplied buffer.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestEntriesInRange(t *testing.T) {
+	om := NewOrderedMap[int, string]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 300; i++ {
+		k := rng.Intn(1000)
+		om.Put(k, string(rune('a'+k%26)))
+	}
+
+	var buf []Entry[int, string]
+	for i := 0; i < 200; i++ {
+		lo, hi := rng.Intn(1100)-50, rng.Intn(1100)-50
+		keys := om.KeysInRange(lo, hi)
+
+		entries := om.EntriesInRange(lo, hi)
+		values := om.ValuesInRange(lo, hi)
+		buf = om.AppendEntriesInRange(buf[:0], lo, hi)
+		if len(entries) != len(keys) || len(values) != len(keys) || !slices.Equal(buf, entries) {
+			t.Fatalf("[%d, %d]: expected %d entries, got %d, %d values and %d appended",
+				lo, hi, len(keys), len(entries), len(values), len(buf))
+		}
+		for j, k := range keys {
+			v, _ := om.Get(k)
+			if entries[j] != (Entry[int, string]{k, v}) || values[j] != v {
+				t.Errorf("[%d, %d]: expected %d=%s at %d, got %v and %s", lo, hi, k, v, j, entries[j], values[j])
+			}
+		}
+	}
+
+	prefix := []Entry[int, string]{{-1, "x"}}
+	got := om.AppendEntriesBetween(prefix, Unbounded[int](), Unbounded[int]())
+	if len(got) != om.Size()+1 || got[0] != prefix[0] {
+		t.Errorf("AppendEntriesBetween: expected the prefix followed by %d entries, got %d", om.Size(), len(got))
+	}
+}
@@ -0,0 +1,81 @@
+package orderedmap
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NewFoldedStringMap creates and returns a new empty OrderedMap whose string keys
+// are ordered and matched by their case-folded, Unicode-normalized (NFD) form, so
+// "Zoë", "ZOË" and "Zoe\u0308" are the same key and sort between "zoe" and "zof".
+// The map stores and returns the spelling of the key that was first inserted; a
+// later Put with a different spelling only replaces the value.
+//
+// Keys are folded during each comparison, not once when stored. Comparing two
+// ASCII keys costs about as much as strings.Compare, but once the keys differ in
+// a non-ASCII character the rest of both keys is normalized and folded, which
+// allocates. Get and Put make O(log n) such comparisons; see BenchmarkCompareFolded.
+func NewFoldedStringMap[V any]() *OrderedMap[string, V] {
+	return NewOrderedMapFunc[string, V](CompareFolded)
+}
+
+// CompareFolded compares two strings by their case-folded NFD form. Strings that
+// are pure ASCII are compared without allocating. Otherwise only the parts after
+// their common ASCII prefix are folded, so keys that share a long path such as
+// "/users/42/" pay for the characters that follow it.
+func CompareFolded(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		ca, cb := a[i], b[i]
+		if ca >= utf8.RuneSelf || cb >= utf8.RuneSelf {
+			// a[:i] and b[:i] are ASCII and equal but for case, and a
+			// combining mark at i can only attach to an ASCII starter
+			return strings.Compare(foldString(a[i:]), foldString(b[i:]))
+		}
+		ca, cb = lowerASCII(ca), lowerASCII(cb)
+		if ca != cb {
+			if ca < cb {
+				return -1
+			}
+			return 1
+		}
+	}
+	if !isASCII(a[n:]) || !isASCII(b[n:]) {
+		return strings.Compare(foldString(a[n:]), foldString(b[n:]))
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// foldString returns the canonical caseless form of s, NFD(fold(NFD(s))).
+// A new Caser is used for every call because Casers are stateful.
+func foldString(s string) string {
+	return norm.NFD.String(cases.Fold().String(norm.NFD.String(s)))
+}
+
+// lowerASCII maps an ASCII upper-case letter to lower case.
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
+
+// isASCII reports whether s contains only ASCII bytes.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFoldedStringMap tests that a map created with NewFoldedStringMap treats
+// differently cased and differently normalized spellings as the same key, orders
+// keys naturally and keeps the original spelling of the stored key.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestFoldedStringMap(t *testing.T) {
+	om := NewFoldedStringMap[int]()
+	om.Put("Zoe\u0308", 1)
+	om.Put("ZOË", 2)
+	om.Put("Zoë", 3)
+	om.Put("zof", 4)
+	om.Put("ZOE", 5)
+	om.Put("Straße", 6)
+	om.Put("STRASSE", 7)
+
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Straße", "ZOE", "Zoe\u0308", "zof"}; !slices.Equal(om.Keys(), want) {
+		t.Errorf("Keys: expected %q, got %q", want, om.Keys())
+	}
+	for _, k := range []string{"zoe\u0308", "ZOË", "zoë", "ZOË"} {
+		if v, found := om.Get(k); !found || v != 3 {
+			t.Errorf("Get(%q): expected 3, got %d (found %v)", k, v, found)
+		}
+	}
+	if v, _ := om.Get("strasse"); v != 7 {
+		t.Errorf("Get(%q): expected 7, got %d", "strasse", v)
+	}
+
+	om.Delete("ZOË")
+	if om.Contains("Zoe\u0308") || om.Size() != 3 {
+		t.Errorf("Delete(%q) failed: %q", "ZOË", om.Keys())
+	}
+}
+
+// TestCompareFolded tests that the ASCII fast path of CompareFolded agrees with a
+// full case fold for random strings mixing ASCII and non-ASCII characters.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestCompareFolded(t *testing.T) {
+	alphabet := []string{"a", "A", "b", "B", "z", "Z", "_", "e\u0308", "Ë", "ë", "ß", "K", "\u212a", "\u0323"}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	word := func() string {
+		var sb strings.Builder
+		for i := rng.Intn(5); i >= 0; i-- {
+			sb.WriteString(alphabet[rng.Intn(len(alphabet))])
+		}
+		return sb.String()
+	}
+
+	for i := 0; i < 10000; i++ {
+		a, b := word(), word()
+		want := strings.Compare(foldString(a), foldString(b))
+		if got := CompareFolded(a, b); got != want {
+			t.Errorf("CompareFolded(%q, %q): expected %d, got %d", a, b, want, got)
+		}
+	}
+}
+
+// BenchmarkCompareFolded measures CompareFolded on keys that differ in an ASCII
+// character, which takes the allocation-free path, and on keys that differ in a
+// non-ASCII character after a shared prefix, which folds the rest of both keys.
+func BenchmarkCompareFolded(b *testing.B) {
+	for _, bc := range []struct{ name, a, b string }{
+		{"ASCII", "/users/42/Zoe", "/users/42/zoey"},
+		{"NonASCII", "/users/42/Zo\u00eb", "/users/42/ZOE\u0308y"},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				CompareFolded(bc.a, bc.b)
+			}
+		})
+	}
+}
@@ -1,5 +1,8 @@
 module github.com/dmh2000/orderedmap
 
-go 1.22.5
+go 1.23
 
-require golang.org/x/exp v0.0.0-20240719175910-8a7402abbf56
+require (
+	golang.org/x/exp v0.0.0-20240719175910-8a7402abbf56
+	golang.org/x/text v0.21.0
+)
@@ -0,0 +1,125 @@
+package orderedmap
+
+import "iter"
+
+// All returns an iterator over the key-value pairs of the OrderedMap in ascending
+// key order. The tree is walked lazily with an explicit stack, so a full iteration
+// allocates O(log n) and breaking out of the loop stops the walk.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(nil, func(x *node[K, V]) bool {
+			return yield(x.key, x.val)
+		})
+	}
+}
+
+// Backward returns an iterator over the key-value pairs of the OrderedMap in
+// descending key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(nil, func(x *node[K, V]) bool {
+			return yield(x.key, x.val)
+		})
+	}
+}
+
+// AllKeys returns an iterator over the keys of the OrderedMap in ascending order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		t.ascend(nil, func(x *node[K, V]) bool {
+			return yield(x.key)
+		})
+	}
+}
+
+// AllValues returns an iterator over the values of the OrderedMap in ascending
+// key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		t.ascend(nil, func(x *node[K, V]) bool {
+			return yield(x.val)
+		})
+	}
+}
+
+// Range returns an iterator over the key-value pairs of the OrderedMap with keys
+// between lo and hi, inclusive, in ascending key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return t.RangeBetween(Inclusive(lo), Inclusive(hi))
+}
+
+// BackwardRange returns an iterator over the key-value pairs of the OrderedMap with
+// keys between lo and hi, inclusive, in descending key order.
+// The map must not be modified during iteration.
+func (t *OrderedMap[K, V]) BackwardRange(lo, hi K) iter.Seq2[K, V] {
+	return t.BackwardBetween(Inclusive(lo), Inclusive(hi))
+}
+
+// ascend calls yield for every node whose key lies in the range described by pos,
+// in ascending key order, until yield returns false.
+// pos reports whether a key is below (< 0), inside (0) or above (> 0) the range
+// and must be monotone in the key order. A nil pos selects every node.
+func (t *OrderedMap[K, V]) ascend(pos func(K) int, yield func(*node[K, V]) bool) {
+	var buf [64]*node[K, V]
+	stack := buf[:0]
+
+	// seek to the first node inside the range, remembering the path
+	for x := t.root; x != nil; {
+		if pos != nil && pos(x.key) < 0 {
+			x = x.right
+		} else {
+			stack = append(stack, x)
+			x = x.left
+		}
+	}
+
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if pos != nil && pos(x.key) > 0 {
+			return
+		}
+		if !yield(x) {
+			return
+		}
+		for y := x.right; y != nil; y = y.left {
+			stack = append(stack, y)
+		}
+	}
+}
+
+// descend calls yield for every node whose key lies in the range described by pos,
+// in descending key order, until yield returns false. See ascend for pos.
+func (t *OrderedMap[K, V]) descend(pos func(K) int, yield func(*node[K, V]) bool) {
+	var buf [64]*node[K, V]
+	stack := buf[:0]
+
+	// seek to the last node inside the range, remembering the path
+	for x := t.root; x != nil; {
+		if pos != nil && pos(x.key) > 0 {
+			x = x.left
+		} else {
+			stack = append(stack, x)
+			x = x.right
+		}
+	}
+
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if pos != nil && pos(x.key) < 0 {
+			return
+		}
+		if !yield(x) {
+			return
+		}
+		for y := x.left; y != nil; y = y.right {
+			stack = append(stack, y)
+		}
+	}
+}
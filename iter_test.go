@@ -0,0 +1,131 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestIterators tests the All, Backward, AllKeys, AllValues and Range iterators.
+//
+// It fills a map with random keys and compares the iterator output with the
+// slices returned by Keys and KeysInRange, including early break out of a loop.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestIterators(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 1000; i++ {
+		k := rng.Intn(5000)
+		om.Put(k, -k)
+	}
+	keys := om.Keys()
+
+	var got []int
+	for k, v := range om.All() {
+		if v != -k {
+			t.Errorf("All: expected value %d for key %d, got %d", -k, k, v)
+		}
+		got = append(got, k)
+	}
+	if !slices.Equal(got, keys) {
+		t.Errorf("All: keys differ from Keys()")
+	}
+
+	if got := slices.Collect(om.AllKeys()); !slices.Equal(got, keys) {
+		t.Errorf("AllKeys: keys differ from Keys()")
+	}
+
+	values := slices.Collect(om.AllValues())
+	for i, v := range values {
+		if v != -keys[i] {
+			t.Errorf("AllValues: expected %d at %d, got %d", -keys[i], i, v)
+		}
+	}
+
+	got = got[:0]
+	for k := range om.Backward() {
+		got = append(got, k)
+	}
+	slices.Reverse(got)
+	if !slices.Equal(got, keys) {
+		t.Errorf("Backward: keys differ from reversed Keys()")
+	}
+
+	for i := 0; i < 100; i++ {
+		lo, hi := rng.Intn(5200)-100, rng.Intn(5200)-100
+		got = got[:0]
+		for k := range om.Range(lo, hi) {
+			got = append(got, k)
+		}
+		if want := om.KeysInRange(lo, hi); !slices.Equal(got, want) {
+			t.Errorf("Range(%d, %d): expected %v, got %v", lo, hi, want, got)
+		}
+	}
+
+	n := 0
+	for range om.All() {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	if n != 10 {
+		t.Errorf("expected to stop after 10 items, got %d", n)
+	}
+
+	for range NewOrderedMap[int, int]().All() {
+		t.Error("All on an empty map should not yield")
+	}
+}
+
+// TestBackwardRangeAndLastN tests reverse range scans with BackwardRange, early
+// termination of a backward scan, and LastN.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestBackwardRangeAndLastN(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	for i := 0; i < 100; i++ {
+		om.Put(i, i*i)
+	}
+
+	var got []int
+	for k, v := range om.BackwardRange(20, 30) {
+		if v != k*k {
+			t.Errorf("BackwardRange: expected value %d for key %d, got %d", k*k, k, v)
+		}
+		got = append(got, k)
+	}
+	if want := []int{30, 29, 28, 27, 26, 25, 24, 23, 22, 21, 20}; !slices.Equal(got, want) {
+		t.Errorf("BackwardRange(20, 30): expected %v, got %v", want, got)
+	}
+
+	got = got[:0]
+	for k := range om.BackwardRange(50, 1000) {
+		got = append(got, k)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if want := []int{99, 98, 97}; !slices.Equal(got, want) {
+		t.Errorf("BackwardRange(50, 1000) with break: expected %v, got %v", want, got)
+	}
+
+	last := om.LastN(3)
+	if want := []Entry[int, int]{{99, 9801}, {98, 9604}, {97, 9409}}; !slices.Equal(last, want) {
+		t.Errorf("LastN(3): expected %v, got %v", want, last)
+	}
+	if n := len(om.LastN(500)); n != 100 {
+		t.Errorf("LastN(500): expected 100 entries, got %d", n)
+	}
+	if n := len(om.LastN(0)); n != 0 {
+		t.Errorf("LastN(0): expected no entries, got %d", n)
+	}
+}
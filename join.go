@@ -0,0 +1,220 @@
+package orderedmap
+
+import "errors"
+
+// ErrJoinOrder is returned by Join when the keys of the two maps interleave or the
+// maps are visibly ordered differently.
+var ErrJoinOrder = errors.New("orderedmap: key ranges overlap")
+
+// Split moves the entries of the map into two new maps, one with the keys less
+// than key and one with the keys greater than or equal to key, and leaves the map
+// empty. Both maps keep the ordering of the original. The nodes are reused rather
+// than copied, so Split runs in O(log n).
+func (t *OrderedMap[K, V]) Split(key K) (*OrderedMap[K, V], *OrderedMap[K, V]) {
+	below := func(k K) bool { return t.compare(k, key) < 0 }
+	lo, hi := t.empty(), t.empty()
+	lo.root, _, hi.root, _ = t.split(t.root, t.blackHeight(t.root), below)
+	t.root = nil
+	return lo, hi
+}
+
+// Join moves all entries of other into the map and leaves other empty. Every key
+// of other must be either less than or greater than every key of the map;
+// otherwise Join returns ErrJoinOrder and neither map is changed. Join also returns
+// ErrJoinOrder if the smallest and largest keys of either map are out of order
+// under the ordering of the map, which catches other maps ordered in reverse.
+// Joining maps with otherwise incompatible comparison functions is undefined. The
+// trees are linked at matching black heights, so Join runs in O(log n + log m).
+func (t *OrderedMap[K, V]) Join(other *OrderedMap[K, V]) error {
+	if other == t || other.IsEmpty() {
+		return nil
+	}
+	if t.IsEmpty() {
+		if t.cmp == nil {
+			// the zero value takes over the ordering of other
+			*t = *other.empty()
+		} else if !t.ascending(other.root) {
+			return ErrJoinOrder
+		}
+		t.root, other.root = other.root, nil
+		return nil
+	}
+
+	l, r := t.root, other.root
+	if !t.ascending(l) || !t.ascending(r) {
+		return ErrJoinOrder
+	}
+	switch {
+	case t.compare(t.max(l).key, t.min(r).key) < 0:
+	case t.compare(t.max(r).key, t.min(l).key) < 0:
+		l, r = r, l
+	default:
+		return ErrJoinOrder
+	}
+	t.root, _ = t.join2(l, t.blackHeight(l), r)
+	other.root = nil
+	return nil
+}
+
+// DeleteRange removes every entry with a key between the bounds lo and hi and
+// returns the number of removed entries. The tree is split around the range and
+// the remaining parts are joined again, so the cost is O(log n) for the tree
+// surgery no matter how many entries are removed.
+func (t *OrderedMap[K, V]) DeleteRange(lo, hi Bound[K]) int {
+	return t.DeleteRangeFunc(t.between(lo, hi))
+}
+
+// DeleteRangeFunc removes every entry whose key lies in the range described by pos
+// and returns the number of removed entries. See RangeFunc for pos.
+func (t *OrderedMap[K, V]) DeleteRangeFunc(pos func(K) int) int {
+	n := t.Size()
+	if pos == nil {
+		t.root = nil
+		return n
+	}
+	if n == 0 {
+		return 0
+	}
+
+	below := func(key K) bool { return pos(key) < 0 }
+	notAbove := func(key K) bool { return pos(key) <= 0 }
+
+	l, lh, rest, resth := t.split(t.root, t.blackHeight(t.root), below)
+	mid, _, r, _ := t.split(rest, resth, notAbove)
+	t.root, _ = t.join2(l, lh, r)
+	return t.size(mid)
+}
+
+// ascending reports whether the minimum of the subtree rooted at x sorts before its
+// maximum under the ordering of the map, or the subtree holds a single node.
+func (t *OrderedMap[K, V]) ascending(x *node[K, V]) bool {
+	lo, hi := t.min(x), t.max(x)
+	return lo == hi || t.compare(lo.key, hi.key) < 0
+}
+
+// blackHeight returns the number of black nodes on any path from x down to a leaf.
+func (t *OrderedMap[K, V]) blackHeight(x *node[K, V]) int {
+	n := 0
+	for ; x != nil; x = x.left {
+		if !t.isRed(x) {
+			n++
+		}
+	}
+	return n
+}
+
+// split partitions the subtree rooted at h, whose black height is hh, into the
+// keys for which goesLeft is true and the rest. goesLeft must be monotone: true
+// for a prefix of the keys in order. Both parts are returned with black roots
+// together with their black heights. The nodes of h are reused.
+func (t *OrderedMap[K, V]) split(h *node[K, V], hh int, goesLeft func(K) bool) (*node[K, V], int, *node[K, V], int) {
+	if h == nil {
+		return nil, 0, nil, 0
+	}
+
+	// black height of the children of h
+	ch := hh
+	if !t.isRed(h) {
+		ch--
+	}
+
+	left, right := h.left, h.right
+	if goesLeft(h.key) {
+		rl, rlh, rr, rrh := t.split(right, ch, goesLeft)
+		l, lh := t.join(left, ch, h, rl, rlh)
+		return l, lh, rr, rrh
+	}
+	ll, llh, lr, lrh := t.split(left, ch, goesLeft)
+	r, rh := t.join(lr, lrh, h, right, ch)
+	return ll, llh, r, rh
+}
+
+// join returns a tree holding the keys of l, the node k and the keys of r, where
+// every key in l is less than k.key and every key in r is greater. hl and hr are
+// the black heights of l and r. The result has a black root and is returned with
+// its black height. It runs in O(|hl - hr| + 1).
+func (t *OrderedMap[K, V]) join(l *node[K, V], hl int, k *node[K, V], r *node[K, V], hr int) (*node[K, V], int) {
+	if t.isRed(l) {
+		l.color = BLACK
+		hl++
+	}
+	if t.isRed(r) {
+		r.color = BLACK
+		hr++
+	}
+
+	var h *node[K, V]
+	switch {
+	case hl > hr:
+		h = t.joinRight(l, hl, k, r, hr)
+	case hl < hr:
+		h = t.joinLeft(l, hl, k, r, hr)
+	default:
+		h = t.link(l, k, r)
+	}
+
+	bh := max(hl, hr)
+	if t.isRed(h) {
+		h.color = BLACK
+		bh++
+	}
+	return h, bh
+}
+
+// joinRight descends the right spine of the black-rooted tree h, whose black
+// height hh is greater than hr, and links k and r in at black height hr. The new
+// red link is then fixed on the way up exactly like an insertion.
+func (t *OrderedMap[K, V]) joinRight(h *node[K, V], hh int, k *node[K, V], r *node[K, V], hr int) *node[K, V] {
+	if hh == hr {
+		return t.link(h, k, r)
+	}
+	h.right = t.joinRight(h.right, hh-1, k, r, hr)
+	return t.balance(h)
+}
+
+// joinLeft descends the left spine of the black-rooted tree h, whose black height
+// hh is greater than hl, and links l and k in at black height hl. Red left links
+// on the spine are stepped over, since they do not change the black height.
+func (t *OrderedMap[K, V]) joinLeft(l *node[K, V], hl int, k *node[K, V], h *node[K, V], hh int) *node[K, V] {
+	if hh == hl {
+		return t.link(l, k, h)
+	}
+	if t.isRed(h.left) {
+		h.left.left = t.joinLeft(l, hl, k, h.left.left, hh-1)
+		h.left = t.balance(h.left)
+	} else {
+		h.left = t.joinLeft(l, hl, k, h.left, hh-1)
+	}
+	return t.balance(h)
+}
+
+// link makes k a red node with children l and r, which must be black and of equal
+// black height.
+func (t *OrderedMap[K, V]) link(l, k, r *node[K, V]) *node[K, V] {
+	k.left, k.right = l, r
+	k.color = RED
+	k.size = t.size(l) + t.size(r) + 1
+	return k
+}
+
+// join2 returns a tree holding the keys of l and r, where every key in l is less
+// than every key in r, together with its black height. hl is the black height of
+// l. The smallest node of r is removed and used to join the two trees.
+func (t *OrderedMap[K, V]) join2(l *node[K, V], hl int, r *node[K, V]) (*node[K, V], int) {
+	if r == nil {
+		if t.isRed(l) {
+			l.color = BLACK
+			hl++
+		}
+		return l, hl
+	}
+
+	if !t.isRed(r.left) && !t.isRed(r.right) {
+		r.color = RED
+	}
+	r, k := t.deleteMin(r)
+	if r != nil {
+		r.color = BLACK
+	}
+	return t.join(l, hl, k, r, t.blackHeight(r))
+}
@@ -0,0 +1,185 @@
+package orderedmap
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestDeleteRange tests DeleteRange with random inclusive, exclusive and unbounded
+// ends on maps of different sizes, comparing the result with a linear filter and
+// validating the tree structure after every call.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestDeleteRange(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for i := 0; i < 500; i++ {
+		om := NewOrderedMap[int, int]()
+		for j := rng.Intn(300); j > 0; j-- {
+			k := rng.Intn(1000)
+			om.Put(k, k)
+		}
+		lo, hi := randomBound(rng), randomBound(rng)
+		name := fmt.Sprintf("(%+v, %+v)", lo, hi)
+
+		var want []int
+		removed := 0
+		for _, k := range om.Keys() {
+			if inBounds(k, lo, hi) {
+				removed++
+			} else {
+				want = append(want, k)
+			}
+		}
+
+		if n := om.DeleteRange(lo, hi); n != removed {
+			t.Errorf("DeleteRange%s: expected %d removed entries, got %d", name, removed, n)
+		}
+		if err := om.Validate(); err != nil {
+			t.Fatalf("DeleteRange%s: %v", name, err)
+		}
+		if got := om.Keys(); !slices.Equal(got, want) {
+			t.Errorf("DeleteRange%s: expected %v, got %v", name, want, got)
+		}
+		for _, k := range want {
+			if v, found := om.Get(k); !found || v != k {
+				t.Errorf("DeleteRange%s: lost key %d", name, k)
+			}
+		}
+	}
+}
+
+// TestSplitJoin tests the internal split and join operations directly: splitting a
+// random tree at every possible position and joining the parts back must give two
+// valid trees and then the original keys.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestSplitJoin(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for n := 0; n < 70; n++ {
+		for at := -1; at <= n; at++ {
+			om := NewOrderedMap[int, int]()
+			for _, k := range rng.Perm(n) {
+				om.Put(k, k)
+			}
+
+			l, lh, r, rh := om.split(om.root, om.blackHeight(om.root), func(k int) bool { return k < at })
+			lm := &OrderedMap[int, int]{root: l, cmp: om.cmp}
+			rm := &OrderedMap[int, int]{root: r, cmp: om.cmp}
+			if err := lm.Validate(); err != nil || lh != lm.blackHeight(l) || lm.CountInRange(0, at-1) != lm.Size() {
+				t.Fatalf("split(%d) of %d keys: bad left part: %v", at, n, err)
+			}
+			if err := rm.Validate(); err != nil || rh != rm.blackHeight(r) || rm.CountInRange(at, n) != rm.Size() {
+				t.Fatalf("split(%d) of %d keys: bad right part: %v", at, n, err)
+			}
+			if lm.Size()+rm.Size() != n {
+				t.Fatalf("split(%d) of %d keys: lost keys", at, n)
+			}
+
+			om.root, _ = om.join2(l, lh, r)
+			if err := om.Validate(); err != nil || om.Size() != n {
+				t.Fatalf("join2 after split(%d) of %d keys: %v", at, n, err)
+			}
+		}
+	}
+}
+
+// TestSplitAndJoinMaps tests the public Split and Join: splitting at random keys
+// must partition the entries, joining the halves in either order must restore the
+// map, and joining overlapping or reverse ordered maps must fail without changing
+// either map.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestSplitAndJoinMaps(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 300; i++ {
+		om := NewOrderedMap[int, int]()
+		for j := rng.Intn(500); j > 0; j-- {
+			k := rng.Intn(1000)
+			om.Put(k, -k)
+		}
+		keys := om.Keys()
+		at := rng.Intn(1100) - 50
+
+		lo, hi := om.Split(at)
+		if !om.IsEmpty() {
+			t.Fatalf("Split(%d): receiver not emptied", at)
+		}
+		for _, m := range []*OrderedMap[int, int]{lo, hi} {
+			if err := m.Validate(); err != nil {
+				t.Fatalf("Split(%d): %v", at, err)
+			}
+		}
+		if n := lo.Size(); lo.CountBetween(Unbounded[int](), Exclusive(at)) != n ||
+			hi.CountBetween(Inclusive(at), Unbounded[int]()) != hi.Size() || n+hi.Size() != len(keys) {
+			t.Fatalf("Split(%d): bad partition %d/%d of %d keys", at, n, hi.Size(), len(keys))
+		}
+
+		if i%2 == 0 {
+			lo, hi = hi, lo
+		}
+		if err := lo.Join(hi); err != nil {
+			t.Fatalf("Join after Split(%d): %v", at, err)
+		}
+		if err := lo.Validate(); err != nil {
+			t.Fatalf("Join after Split(%d): %v", at, err)
+		}
+		if !hi.IsEmpty() || !slices.Equal(lo.Keys(), keys) {
+			t.Fatalf("Join after Split(%d): keys not restored", at)
+		}
+		for _, k := range keys {
+			if v, found := lo.Get(k); !found || v != -k {
+				t.Fatalf("Join after Split(%d): lost key %d", at, k)
+			}
+		}
+	}
+
+	a, b := NewOrderedMap[int, int](), NewOrderedMap[int, int]()
+	a.Put(1, 1)
+	a.Put(5, 5)
+	b.Put(3, 3)
+	if err := a.Join(b); err != ErrJoinOrder {
+		t.Errorf("Join of overlapping maps: expected ErrJoinOrder, got %v", err)
+	}
+	if a.Size() != 2 || b.Size() != 1 {
+		t.Errorf("Join of overlapping maps changed the maps")
+	}
+
+	asc := NewOrderedMap[int, int]()
+	desc := NewOrderedMapFunc[int, int](func(a, b int) int { return b - a })
+	for k := 1; k <= 2; k++ {
+		asc.Put(k, k)
+	}
+	for k := 4; k <= 6; k++ {
+		desc.Put(k, k)
+	}
+	if err := asc.Join(desc); err != ErrJoinOrder {
+		t.Errorf("Join of reverse ordered map: expected ErrJoinOrder, got %v", err)
+	}
+	if !slices.Equal(asc.Keys(), []int{1, 2}) || !slices.Equal(desc.Keys(), []int{6, 5, 4}) {
+		t.Errorf("Join of reverse ordered map changed the maps")
+	}
+	if err := desc.Join(asc); err != ErrJoinOrder {
+		t.Errorf("Join into reverse ordered map: expected ErrJoinOrder, got %v", err)
+	}
+
+	empty := NewOrderedMapFunc[int, int](func(a, b int) int { return b - a })
+	if err := empty.Join(asc); err != ErrJoinOrder {
+		t.Errorf("Join into empty reverse ordered map: expected ErrJoinOrder, got %v", err)
+	}
+	if !empty.IsEmpty() || !slices.Equal(asc.Keys(), []int{1, 2}) {
+		t.Errorf("Join into empty reverse ordered map changed the maps")
+	}
+}
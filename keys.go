@@ -0,0 +1,104 @@
+package orderedmap
+
+import (
+	"bytes"
+	"cmp"
+	"math/big"
+	"net/netip"
+	"slices"
+	"time"
+)
+
+// NewTimeMap creates and returns a new empty OrderedMap keyed by time.Time.
+// Keys are compared by the instant they represent, see CompareTime.
+func NewTimeMap[V any]() *OrderedMap[time.Time, V] {
+	return NewOrderedMapFunc[time.Time, V](CompareTime)
+}
+
+// NewAddrMap creates and returns a new empty OrderedMap keyed by netip.Addr,
+// ordered by netip.Addr.Compare: invalid addresses first, then IPv4 before IPv6.
+func NewAddrMap[V any]() *OrderedMap[netip.Addr, V] {
+	return NewOrderedMapFunc[netip.Addr, V](netip.Addr.Compare)
+}
+
+// NewPrefixMap creates and returns a new empty OrderedMap keyed by netip.Prefix,
+// ordered by ComparePrefix.
+func NewPrefixMap[V any]() *OrderedMap[netip.Prefix, V] {
+	return NewOrderedMapFunc[netip.Prefix, V](ComparePrefix)
+}
+
+// NewBigIntMap creates and returns a new empty OrderedMap keyed by *big.Int,
+// ordered by numeric value with nil before every number. The map stores a copy
+// of each inserted key, so the caller may reuse or modify the *big.Int it passed
+// to Put. Keys returned by the map must not be modified.
+func NewBigIntMap[V any]() *OrderedMap[*big.Int, V] {
+	m := NewOrderedMapFunc[*big.Int, V](CompareBigInt)
+	m.copyKey = func(k *big.Int) *big.Int {
+		if k == nil {
+			return nil
+		}
+		return new(big.Int).Set(k)
+	}
+	return m
+}
+
+// NewBytesMap creates and returns a new empty OrderedMap keyed by byte slices,
+// ordered by bytes.Compare. A nil and an empty slice are the same key. The map
+// stores a copy of each inserted key, so the caller may reuse the slice it
+// passed to Put. Keys returned by the map must not be modified.
+func NewBytesMap[V any]() *OrderedMap[[]byte, V] {
+	m := NewOrderedMapFunc[[]byte, V](bytes.Compare)
+	m.copyKey = slices.Clone[[]byte]
+	return m
+}
+
+// CompareTime compares two times by the instant they represent. Unlike
+// time.Time.Compare it ignores monotonic clock readings, and like it, it ignores
+// the location, so the same instant in two time zones is the same key.
+func CompareTime(a, b time.Time) int {
+	if c := cmp.Compare(a.Unix(), b.Unix()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Nanosecond(), b.Nanosecond())
+}
+
+// ComparePrefix compares two prefixes. Prefixes sort first by validity (invalid
+// before valid), then by address family (IPv4 before IPv6), then by masked
+// prefix address, then by prefix length, and finally by the unmasked address,
+// so a prefix sorts directly before the longer prefixes it contains. Invalid
+// prefixes, including ones with an address but an out of range length, are
+// ordered among themselves by address.
+func ComparePrefix(a, b netip.Prefix) int {
+	switch av, bv := a.IsValid(), b.IsValid(); {
+	case !av && !bv:
+		return a.Addr().Compare(b.Addr())
+	case !av:
+		return -1
+	case !bv:
+		return 1
+	}
+	if c := cmp.Compare(a.Addr().BitLen(), b.Addr().BitLen()); c != 0 {
+		return c
+	}
+	if c := a.Masked().Addr().Compare(b.Masked().Addr()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Bits(), b.Bits()); c != 0 {
+		return c
+	}
+	return a.Addr().Compare(b.Addr())
+}
+
+// CompareBigInt compares two *big.Int by numeric value. nil sorts before every number.
+func CompareBigInt(a, b *big.Int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return a.Cmp(b)
+	}
+}
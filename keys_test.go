@@ -0,0 +1,146 @@
+package orderedmap
+
+import (
+	"math/big"
+	"net/netip"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestTimeMap tests that a time.Time keyed map matches keys by instant, ignoring
+// the location and the monotonic clock reading.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestTimeMap(t *testing.T) {
+	om := NewTimeMap[string]()
+	now := time.Now() // has a monotonic clock reading
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	om.Put(now, "now")
+	om.Put(now.Add(time.Nanosecond), "later")
+	om.Put(now.Add(-time.Hour).In(tokyo), "earlier")
+
+	if v, found := om.Get(now.Round(0).In(tokyo)); !found || v != "now" {
+		t.Errorf("Get: expected %q for the same instant in another zone, got %q (found %v)", "now", v, found)
+	}
+	om.Put(now.UTC(), "now again")
+	if om.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", om.Size())
+	}
+	if got := slices.Collect(om.AllValues()); !slices.Equal(got, []string{"earlier", "now again", "later"}) {
+		t.Errorf("Values: unexpected order %q", got)
+	}
+	if CompareTime(time.Unix(-1, 999999999), time.Unix(0, 0)) >= 0 {
+		t.Error("CompareTime: times before the epoch compare wrong")
+	}
+}
+
+// TestAddrAndPrefixMaps tests maps keyed by netip.Addr and netip.Prefix.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestAddrAndPrefixMaps(t *testing.T) {
+	addrs := NewAddrMap[int]()
+	for i, s := range []string{"::1", "10.0.0.2", "10.0.0.10", "192.168.1.1", "fe80::1"} {
+		addrs.Put(netip.MustParseAddr(s), i)
+	}
+	var got []string
+	for a := range addrs.AllKeys() {
+		got = append(got, a.String())
+	}
+	if want := []string{"10.0.0.2", "10.0.0.10", "192.168.1.1", "::1", "fe80::1"}; !slices.Equal(got, want) {
+		t.Errorf("Addr keys: expected %v, got %v", want, got)
+	}
+
+	prefixes := NewPrefixMap[int]()
+	for i, s := range []string{"10.1.0.0/16", "10.0.0.0/16", "10.0.0.0/8", "::/0", "0.0.0.0/0", "10.0.0.1/8"} {
+		prefixes.Put(netip.MustParsePrefix(s), i)
+	}
+	prefixes.Put(netip.Prefix{}, -1)
+	// invalid because of its length, but it still carries an address
+	badBits := netip.PrefixFrom(netip.MustParseAddr("::1"), 200)
+	prefixes.Put(badBits, -2)
+	if err := prefixes.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	got = got[:0]
+	for p := range prefixes.AllKeys() {
+		got = append(got, p.String())
+	}
+	want := []string{"invalid Prefix", "invalid Prefix", "0.0.0.0/0", "10.0.0.0/8", "10.0.0.1/8", "10.0.0.0/16", "10.1.0.0/16", "::/0"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Prefix keys: expected %v, got %v", want, got)
+	}
+	if p, _, _ := prefixes.Select(1); p != badBits {
+		t.Errorf("Prefix keys: expected %v second, got %v", badBits.Addr(), p.Addr())
+	}
+}
+
+// TestBigIntMap tests that a *big.Int keyed map orders keys numerically, sorts nil
+// first and is not affected when the caller modifies a key after Put.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestBigIntMap(t *testing.T) {
+	om := NewBigIntMap[string]()
+	k := new(big.Int)
+	for _, s := range []string{"100000000000000000000", "-5", "42", "7"} {
+		k.SetString(s, 10)
+		om.Put(k, s)
+	}
+	om.Put(nil, "nil")
+	k.SetInt64(43)
+
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := slices.Collect(om.AllValues()); !slices.Equal(got, []string{"nil", "-5", "7", "42", "100000000000000000000"}) {
+		t.Errorf("Values: unexpected order %q", got)
+	}
+	if v, found := om.Get(big.NewInt(42)); !found || v != "42" {
+		t.Errorf("Get(42): expected %q, got %q (found %v)", "42", v, found)
+	}
+	if om.Contains(k) {
+		t.Error("modifying a key after Put must not change the map")
+	}
+}
+
+// TestBytesMap tests that a []byte keyed map orders keys with bytes.Compare and
+// stores a defensive copy of each key.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestBytesMap(t *testing.T) {
+	om := NewBytesMap[int]()
+	buf := []byte("b")
+	om.Put(buf, 1)
+	buf[0] = 'a'
+	om.Put(buf, 2)
+	om.Put([]byte("ab"), 3)
+	om.Put(nil, 4)
+	om.Put([]byte{}, 5)
+
+	var got []string
+	for k, v := range om.All() {
+		got = append(got, string(k))
+		if string(k) == "" && v != 5 {
+			t.Errorf("nil and empty keys should be the same key, got value %d", v)
+		}
+	}
+	if want := []string{"", "a", "ab", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Keys: expected %q, got %q", want, got)
+	}
+	if v, found := om.Get([]byte("b")); !found || v != 1 {
+		t.Errorf("Get(b): expected 1, got %d (found %v)", v, found)
+	}
+}
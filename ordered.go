@@ -0,0 +1,128 @@
+package orderedmap
+
+import (
+	"cmp"
+
+	"golang.org/x/exp/constraints"
+)
+
+// orderedOps holds the single-key operations of a map created by NewOrderedMap.
+// They are instantiated for a constraints.Ordered key type and compare keys with
+// cmp.Less, which compiles down to the < operator for integer and string keys,
+// instead of calling the comparison function of the map at every node on the path.
+// Each is otherwise identical to the method of the same name in orderedmap.go.
+type orderedOps[K, V any] struct {
+	get     func(x *node[K, V], key K) (V, bool)
+	put     func(t *OrderedMap[K, V], h *node[K, V], key K, val V) (*node[K, V], V, bool)
+	compute func(t *OrderedMap[K, V], h *node[K, V], key K, fn func(old V, exists bool) (V, bool)) (*node[K, V], int, int)
+	delete  func(t *OrderedMap[K, V], h *node[K, V], key K) (*node[K, V], V, bool)
+}
+
+// newOrderedOps returns the orderedOps for key type K.
+func newOrderedOps[K constraints.Ordered, V any]() *orderedOps[K, V] {
+	return &orderedOps[K, V]{
+		get:     getOrdered[K, V],
+		put:     putOrdered[K, V],
+		compute: computeOrdered[K, V],
+		delete:  deleteOrdered[K, V],
+	}
+}
+
+// equalOrdered reports whether a and b are the same key, treating all NaNs as
+// the same key like cmp.Compare does.
+func equalOrdered[K constraints.Ordered](a, b K) bool {
+	return a == b || (a != a && b != b)
+}
+
+// getOrdered is get for constraints.Ordered keys.
+func getOrdered[K constraints.Ordered, V any](x *node[K, V], key K) (V, bool) {
+	for x != nil {
+		switch {
+		case cmp.Less(key, x.key):
+			x = x.left
+		case cmp.Less(x.key, key):
+			x = x.right
+		default:
+			return x.val, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// putOrdered is put for constraints.Ordered keys.
+func putOrdered[K constraints.Ordered, V any](t *OrderedMap[K, V], h *node[K, V], key K, val V) (*node[K, V], V, bool) {
+	var old V
+	if h == nil {
+		return t.newNode(key, val), old, false
+	}
+
+	var existed bool
+	switch {
+	case cmp.Less(key, h.key):
+		h.left, old, existed = putOrdered(t, h.left, key, val)
+	case cmp.Less(h.key, key):
+		h.right, old, existed = putOrdered(t, h.right, key, val)
+	default:
+		old = h.val
+		h.val = val
+		return h, old, true
+	}
+	return t.balance(h), old, existed
+}
+
+// computeOrdered is compute for constraints.Ordered keys.
+func computeOrdered[K constraints.Ordered, V any](t *OrderedMap[K, V], h *node[K, V], key K, fn func(old V, exists bool) (V, bool)) (*node[K, V], int, int) {
+	if h == nil {
+		return t.computeMissing(key, fn)
+	}
+
+	switch {
+	case cmp.Less(key, h.key):
+		x, oh, nh := computeOrdered(t, h.left, key, fn)
+		return t.computeUp(h, x, true, oh, nh)
+	case cmp.Less(h.key, key):
+		x, oh, nh := computeOrdered(t, h.right, key, fn)
+		return t.computeUp(h, x, false, oh, nh)
+	default:
+		return t.computeFound(h, fn)
+	}
+}
+
+// deleteOrdered is delete for constraints.Ordered keys.
+func deleteOrdered[K constraints.Ordered, V any](t *OrderedMap[K, V], h *node[K, V], key K) (*node[K, V], V, bool) {
+	var removed V
+	var found bool
+	if cmp.Less(key, h.key) {
+		if h.left == nil {
+			return h, removed, false
+		}
+		if !t.isRed(h.left) && !t.isRed(h.left.left) {
+			h = t.moveRedLeft(h)
+		}
+		h.left, removed, found = deleteOrdered(t, h.left, key)
+	} else {
+		if t.isRed(h.left) {
+			h = t.rotateRight(h)
+		}
+		if h.right == nil {
+			if equalOrdered(key, h.key) {
+				return nil, h.val, true
+			}
+			return h, removed, false
+		}
+		if !t.isRed(h.right) && !t.isRed(h.right.left) {
+			h = t.moveRedRight(h)
+		}
+		if equalOrdered(key, h.key) {
+			removed, found = h.val, true
+			var x *node[K, V]
+			h.right, x = t.deleteMin(h.right)
+			h.key = x.key
+			h.val = x.val
+		} else {
+			h.right, removed, found = deleteOrdered(t, h.right, key)
+		}
+	}
+	return t.balance(h), removed, found
+}
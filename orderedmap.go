@@ -1,6 +1,13 @@
 package orderedmap
 
-import "golang.org/x/exp/constraints"
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
 
 type color bool
 
@@ -9,7 +16,10 @@ const (
 	BLACK color = false
 )
 
-type node[K constraints.Ordered, V any] struct {
+// ErrEmpty is returned by operations that need at least one entry when the map is empty.
+var ErrEmpty = errors.New("orderedmap: map is empty")
+
+type node[K, V any] struct {
 	key         K
 	val         V
 	left, right *node[K, V]
@@ -17,25 +27,147 @@ type node[K constraints.Ordered, V any] struct {
 	size        int
 }
 
-type OrderedMap[K constraints.Ordered, V any] struct {
-	root *node[K, V]
+// OrderedMap is a map whose keys are kept in order in a left-leaning red-black
+// tree. The zero value is an empty map ordered by the natural order of K, like a
+// map created by NewOrderedMap; it panics on the first Put if K is not an integer,
+// floating point or string type. Other key types need NewOrderedMapFunc.
+type OrderedMap[K, V any] struct {
+	root    *node[K, V]
+	cmp     func(a, b K) int
+	copyKey func(K) K
+	ops     *orderedOps[K, V] // set by NewOrderedMap
 }
 
-// NewOrderedMap creates and returns a new empty OrderedMap.
+// NewOrderedMap creates and returns a new empty OrderedMap whose keys are ordered
+// by their natural order, as defined by cmp.Compare. For floating point keys this
+// is a total order: NaN sorts before every other value and all NaNs are the same
+// key, and -0 and +0 are the same key. Get, Put, Delete and Compute on such a map
+// compare keys directly instead of calling a comparison function.
 func NewOrderedMap[K constraints.Ordered, V any]() *OrderedMap[K, V] {
-	return &OrderedMap[K, V]{}
+	return &OrderedMap[K, V]{cmp: cmp.Compare[K], ops: newOrderedOps[K, V]()}
+}
+
+// NewOrderedMapFunc creates and returns a new empty OrderedMap whose keys are ordered
+// by cmp. cmp must return a negative number when a < b, a positive number when
+// a > b and zero when a and b are the same key, and it must define a strict weak
+// ordering. This allows keys such as structs or time.Time that do not support
+// the < and > operators. NewOrderedMapFunc panics if cmp is nil.
+func NewOrderedMapFunc[K, V any](cmp func(a, b K) int) *OrderedMap[K, V] {
+	if cmp == nil {
+		panic("orderedmap: NewOrderedMapFunc called with a nil comparison function")
+	}
+	return &OrderedMap[K, V]{cmp: cmp}
+}
+
+// naturalCompare returns a function that orders keys of type K by their natural
+// order, as cmp.Compare does. It is used by the zero value of OrderedMap, which
+// has no comparison function, and panics if K has no natural order.
+func naturalCompare[K any]() func(a, b K) int {
+	switch typ := reflect.TypeFor[K](); typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b K) int { return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b K) int { return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b K) int { return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()) }
+	case reflect.String:
+		return func(a, b K) int { return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String()) }
+	default:
+		panic(fmt.Sprintf("orderedmap: key type %v has no natural order, use NewOrderedMapFunc", typ))
+	}
+}
+
+// compare orders a and b with the comparison function of the map, or by the
+// natural order of K for the zero value.
+func (t *OrderedMap[K, V]) compare(a, b K) int {
+	if t.cmp == nil {
+		return naturalCompare[K]()(a, b)
+	}
+	return t.cmp(a, b)
+}
+
+// empty returns a new empty map with the same ordering and key handling as t.
+func (t *OrderedMap[K, V]) empty() *OrderedMap[K, V] {
+	m := *t
+	m.root = nil
+	return &m
 }
 
 // Get retrieves the value associated with the given key.
 func (t *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if t.ops != nil {
+		return t.ops.get(t.root, key)
+	}
 	return t.get(t.root, key)
 }
 
 // Put inserts a key-value pair into the OrderedMap.
 // If the key already exists, its value is updated.
-func (t *OrderedMap[K, V]) Put(key K, val V) {
-	t.root = t.put(t.root, key, val)
+// Put returns the previous value and true if the key existed, or the zero value
+// of V and false if the key was inserted.
+func (t *OrderedMap[K, V]) Put(key K, val V) (V, bool) {
+	var old V
+	var existed bool
+	if t.ops != nil {
+		t.root, old, existed = t.ops.put(t, t.root, key, val)
+	} else {
+		t.root, old, existed = t.put(t.root, key, val)
+	}
 	t.root.color = BLACK
+	return old, existed
+}
+
+// GetOrPut returns the value associated with key and true if the key exists.
+// Otherwise it inserts val and returns val and false. The tree is descended only once.
+func (t *OrderedMap[K, V]) GetOrPut(key K, val V) (V, bool) {
+	var found bool
+	t.Compute(key, func(old V, exists bool) (V, bool) {
+		if exists {
+			val, found = old, true
+		}
+		return val, true
+	})
+	return val, found
+}
+
+// Update sets the value of key to fn(old, exists), where old is the current value
+// and exists tells whether the key was present, and returns the new value.
+// The tree is descended only once.
+func (t *OrderedMap[K, V]) Update(key K, fn func(old V, exists bool) V) V {
+	val, _ := t.Compute(key, func(old V, exists bool) (V, bool) {
+		return fn(old, exists), true
+	})
+	return val
+}
+
+// Compute calls fn with the current value of key and whether the key exists.
+// If fn returns keep true, the value it returns is stored under key, inserting
+// the key if needed; otherwise the key is removed if it exists. Compute returns
+// the stored value and whether the key is present afterwards.
+//
+// Inserting, updating and removing all take a single descent. A removal is
+// repaired on the way back up by joining each node on the path with the subtree
+// below it, as in split and join.
+func (t *OrderedMap[K, V]) Compute(key K, fn func(old V, exists bool) (V, bool)) (V, bool) {
+	var val V
+	var keep bool
+	call := func(old V, exists bool) (V, bool) {
+		val, keep = fn(old, exists)
+		return val, keep
+	}
+	if t.ops != nil {
+		t.root, _, _ = t.ops.compute(t, t.root, key, call)
+	} else {
+		t.root, _, _ = t.compute(t.root, key, call)
+	}
+	if !t.IsEmpty() {
+		t.root.color = BLACK
+	}
+	if !keep {
+		var zero V
+		return zero, false
+	}
+	return val, true
 }
 
 // Contains checks if the given key exists in the OrderedMap.
@@ -45,20 +177,28 @@ func (t *OrderedMap[K, V]) Contains(key K) bool {
 }
 
 // Delete removes the key-value pair with the given key from the OrderedMap.
-// If the key doesn't exist, this operation does nothing.
-func (t *OrderedMap[K, V]) Delete(key K) {
-	if !t.Contains(key) {
-		return
+// It returns the removed value and true, or the zero value of V and false if the
+// key doesn't exist, in which case the map is not changed.
+func (t *OrderedMap[K, V]) Delete(key K) (V, bool) {
+	var removed V
+	if t.IsEmpty() {
+		return removed, false
 	}
 
 	if !t.isRed(t.root.left) && !t.isRed(t.root.right) {
 		t.root.color = RED
 	}
 
-	t.root = t.delete(t.root, key)
+	var found bool
+	if t.ops != nil {
+		t.root, removed, found = t.ops.delete(t, t.root, key)
+	} else {
+		t.root, removed, found = t.delete(t.root, key)
+	}
 	if !t.IsEmpty() {
 		t.root.color = BLACK
 	}
+	return removed, found
 }
 
 // Keys returns a slice containing all keys in the OrderedMap in sorted order.
@@ -101,6 +241,65 @@ func (t *OrderedMap[K, V]) Max() (K, bool) {
 	return t.max(t.root).key, true
 }
 
+// Floor returns the largest key in the OrderedMap less than or equal to key,
+// along with its value and a boolean indicating success.
+// If there is no such key, it returns zero values and false.
+func (t *OrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	return t.entry(t.floor(t.root, key))
+}
+
+// Ceiling returns the smallest key in the OrderedMap greater than or equal to key,
+// along with its value and a boolean indicating success.
+// If there is no such key, it returns zero values and false.
+func (t *OrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return t.entry(t.ceiling(t.root, key))
+}
+
+// Lower returns the largest key in the OrderedMap strictly less than key,
+// along with its value and a boolean indicating success.
+// If there is no such key, it returns zero values and false.
+func (t *OrderedMap[K, V]) Lower(key K) (K, V, bool) {
+	return t.entry(t.lower(t.root, key))
+}
+
+// Higher returns the smallest key in the OrderedMap strictly greater than key,
+// along with its value and a boolean indicating success.
+// If there is no such key, it returns zero values and false.
+func (t *OrderedMap[K, V]) Higher(key K) (K, V, bool) {
+	return t.entry(t.higher(t.root, key))
+}
+
+// Rank returns the number of keys in the OrderedMap strictly less than key.
+func (t *OrderedMap[K, V]) Rank(key K) int {
+	return t.rank(t.root, key, false)
+}
+
+// Select returns the key and value with the given rank, that is the i-th smallest
+// key counting from zero, and a boolean indicating success.
+// If i is out of range, it returns zero values and false.
+func (t *OrderedMap[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= t.Size() {
+		return t.entry(nil)
+	}
+	return t.entry(t.selectNode(t.root, i))
+}
+
+// CountInRange returns the number of keys in the OrderedMap between lo and hi, inclusive.
+// It runs in O(log n) and does not allocate.
+func (t *OrderedMap[K, V]) CountInRange(lo, hi K) int {
+	return t.CountBetween(Inclusive(lo), Inclusive(hi))
+}
+
+// CountInRangeHalfOpen returns the number of keys k in the OrderedMap with lo <= k < hi.
+func (t *OrderedMap[K, V]) CountInRangeHalfOpen(lo, hi K) int {
+	return t.CountBetween(Inclusive(lo), Exclusive(hi))
+}
+
+// CountInRangeOpen returns the number of keys k in the OrderedMap with lo < k < hi.
+func (t *OrderedMap[K, V]) CountInRangeOpen(lo, hi K) int {
+	return t.CountBetween(Exclusive(lo), Exclusive(hi))
+}
+
 // KeysInRange returns a slice of all keys in the OrderedMap between lo and hi, inclusive.
 func (t *OrderedMap[K, V]) KeysInRange(lo, hi K) []K {
 	queue := make([]K, 0)
@@ -124,13 +323,124 @@ func (t *OrderedMap[K, V]) size(x *node[K, V]) int {
 	return x.size
 }
 
+// entry unpacks the key and value of x, or returns zero values and false if x is nil.
+func (t *OrderedMap[K, V]) entry(x *node[K, V]) (K, V, bool) {
+	if x == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return x.key, x.val, true
+}
+
+// floor returns the node with the largest key <= key in the subtree rooted at x.
+func (t *OrderedMap[K, V]) floor(x *node[K, V], key K) *node[K, V] {
+	var best *node[K, V]
+	for x != nil {
+		switch c := t.compare(key, x.key); {
+		case c < 0:
+			x = x.left
+		case c > 0:
+			best = x
+			x = x.right
+		default:
+			return x
+		}
+	}
+	return best
+}
+
+// ceiling returns the node with the smallest key >= key in the subtree rooted at x.
+func (t *OrderedMap[K, V]) ceiling(x *node[K, V], key K) *node[K, V] {
+	var best *node[K, V]
+	for x != nil {
+		switch c := t.compare(key, x.key); {
+		case c < 0:
+			best = x
+			x = x.left
+		case c > 0:
+			x = x.right
+		default:
+			return x
+		}
+	}
+	return best
+}
+
+// lower returns the node with the largest key < key in the subtree rooted at x.
+func (t *OrderedMap[K, V]) lower(x *node[K, V], key K) *node[K, V] {
+	var best *node[K, V]
+	for x != nil {
+		if t.compare(x.key, key) < 0 {
+			best = x
+			x = x.right
+		} else {
+			x = x.left
+		}
+	}
+	return best
+}
+
+// higher returns the node with the smallest key > key in the subtree rooted at x.
+func (t *OrderedMap[K, V]) higher(x *node[K, V], key K) *node[K, V] {
+	var best *node[K, V]
+	for x != nil {
+		if t.compare(x.key, key) > 0 {
+			best = x
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return best
+}
+
+// rank returns the number of keys less than key in the subtree rooted at x.
+// If inclusive is true, a key equal to key is counted as well.
+func (t *OrderedMap[K, V]) rank(x *node[K, V], key K, inclusive bool) int {
+	r := 0
+	for x != nil {
+		switch c := t.compare(key, x.key); {
+		case c < 0:
+			x = x.left
+		case c > 0:
+			r += 1 + t.size(x.left)
+			x = x.right
+		default:
+			if inclusive {
+				r++
+			}
+			return r + t.size(x.left)
+		}
+	}
+	return r
+}
+
+// selectNode returns the node with rank i in the subtree rooted at x.
+// The caller must ensure 0 <= i < size(x).
+func (t *OrderedMap[K, V]) selectNode(x *node[K, V], i int) *node[K, V] {
+	for x != nil {
+		leftSize := t.size(x.left)
+		switch {
+		case i < leftSize:
+			x = x.left
+		case i > leftSize:
+			i -= leftSize + 1
+			x = x.right
+		default:
+			return x
+		}
+	}
+	return nil
+}
+
 // get retrieves the value associated with the given key from the subtree rooted at x.
 func (t *OrderedMap[K, V]) get(x *node[K, V], key K) (V, bool) {
 	for x != nil {
-		switch {
-		case key < x.key:
+		switch c := t.compare(key, x.key); {
+		case c < 0:
 			x = x.left
-		case key > x.key:
+		case c > 0:
 			x = x.right
 		default:
 			return x.val, true
@@ -141,19 +451,23 @@ func (t *OrderedMap[K, V]) get(x *node[K, V], key K) (V, bool) {
 }
 
 // put inserts or updates a key-value pair in the subtree rooted at h.
-func (t *OrderedMap[K, V]) put(h *node[K, V], key K, val V) *node[K, V] {
+// It also returns the previous value and whether the key existed.
+func (t *OrderedMap[K, V]) put(h *node[K, V], key K, val V) (*node[K, V], V, bool) {
+	var old V
 	if h == nil {
-		return &node[K, V]{key: key, val: val, color: RED, size: 1}
+		return t.newNode(key, val), old, false
 	}
 
-	switch {
-	case key < h.key:
-		h.left = t.put(h.left, key, val)
-	case key > h.key:
-		h.right = t.put(h.right, key, val)
+	var existed bool
+	switch c := t.compare(key, h.key); {
+	case c < 0:
+		h.left, old, existed = t.put(h.left, key, val)
+	case c > 0:
+		h.right, old, existed = t.put(h.right, key, val)
 	default:
+		old = h.val
 		h.val = val
-		return h
+		return h, old, true
 	}
 
 	if t.isRed(h.right) && !t.isRed(h.left) {
@@ -167,7 +481,112 @@ func (t *OrderedMap[K, V]) put(h *node[K, V], key K, val V) *node[K, V] {
 	}
 
 	h.size = t.size(h.left) + t.size(h.right) + 1
-	return h
+	return h, old, existed
+}
+
+// newNode returns a new red leaf holding key and val. If the map has a copyKey
+// function, the node holds a copy of key so the caller cannot modify it later.
+// The zero value gets its comparison function when its first key is stored.
+func (t *OrderedMap[K, V]) newNode(key K, val V) *node[K, V] {
+	return t.leaf(t.ownKey(key), val)
+}
+
+// leaf returns a new red leaf holding key and val without copying key. It is used
+// for keys the map already owns.
+func (t *OrderedMap[K, V]) leaf(key K, val V) *node[K, V] {
+	if t.cmp == nil {
+		t.cmp = naturalCompare[K]()
+	}
+	return &node[K, V]{key: key, val: val, color: RED, size: 1}
+}
+
+// ownKey returns key, or a copy of it if the map has a copyKey function.
+func (t *OrderedMap[K, V]) ownKey(key K) K {
+	if t.copyKey != nil {
+		return t.copyKey(key)
+	}
+	return key
+}
+
+// compute finds key in the subtree rooted at h and stores the value returned by fn,
+// inserting a new node if the key is missing and fn asks to keep it, and removing
+// the node if the key exists and fn asks to drop it. It returns the new subtree.
+// If a node was removed, it also returns the black heights of the subtree before
+// and after, which the caller needs to join it back in; otherwise both are -1.
+func (t *OrderedMap[K, V]) compute(h *node[K, V], key K, fn func(old V, exists bool) (V, bool)) (*node[K, V], int, int) {
+	if h == nil {
+		return t.computeMissing(key, fn)
+	}
+
+	switch c := t.compare(key, h.key); {
+	case c < 0:
+		x, oh, nh := t.compute(h.left, key, fn)
+		return t.computeUp(h, x, true, oh, nh)
+	case c > 0:
+		x, oh, nh := t.compute(h.right, key, fn)
+		return t.computeUp(h, x, false, oh, nh)
+	default:
+		return t.computeFound(h, fn)
+	}
+}
+
+// computeMissing calls fn for a key that is not in the map and returns a new leaf
+// for it if fn asks to keep it.
+func (t *OrderedMap[K, V]) computeMissing(key K, fn func(old V, exists bool) (V, bool)) (*node[K, V], int, int) {
+	var zero V
+	val, keep := fn(zero, false)
+	if !keep {
+		return nil, -1, -1
+	}
+	return t.newNode(key, val), -1, -1
+}
+
+// computeFound calls fn for the node h holding the key. If fn drops the key, h is
+// replaced by the join of its children, and the black heights of the subtree
+// before and after are returned.
+func (t *OrderedMap[K, V]) computeFound(h *node[K, V], fn func(old V, exists bool) (V, bool)) (*node[K, V], int, int) {
+	val, keep := fn(h.val, true)
+	if keep {
+		h.val = val
+		return h, -1, -1
+	}
+
+	ch := t.blackHeight(h.left)
+	oh := ch
+	if !t.isRed(h) {
+		oh++
+	}
+	x, nh := t.join2(h.left, ch, h.right)
+	return x, oh, nh
+}
+
+// computeUp links x, the result of a compute in the left or right subtree of h,
+// back into h. If nothing was removed, h is rebalanced as after an insertion.
+// Otherwise the subtree x lost a node and may be shorter than the other child of
+// h, so h is joined with both children by black height instead.
+func (t *OrderedMap[K, V]) computeUp(h, x *node[K, V], left bool, oh, nh int) (*node[K, V], int, int) {
+	if oh < 0 {
+		if left {
+			h.left = x
+		} else {
+			h.right = x
+		}
+		return t.balance(h), -1, -1
+	}
+
+	// both children of h had black height oh
+	hh := oh
+	if !t.isRed(h) {
+		hh++
+	}
+	var y *node[K, V]
+	var yh int
+	if left {
+		y, yh = t.join(x, nh, h, h.right, oh)
+	} else {
+		y, yh = t.join(h.left, oh, h, x, nh)
+	}
+	return y, hh, yh
 }
 
 // rotateRight performs a right rotation on the given node.
@@ -202,97 +621,138 @@ func (t *OrderedMap[K, V]) flipColors(h *node[K, V]) {
 }
 
 // DeleteMin removes the smallest key and associated value from the map.
-func (t *OrderedMap[K, V]) DeleteMin() {
+// It returns ErrEmpty if the map is empty.
+func (t *OrderedMap[K, V]) DeleteMin() error {
+	if _, _, ok := t.PopMin(); !ok {
+		return ErrEmpty
+	}
+	return nil
+}
+
+// PopMin removes the smallest key from the map in a single descent and returns
+// it with its value and true. If the map is empty, it returns zero values and false.
+func (t *OrderedMap[K, V]) PopMin() (K, V, bool) {
 	if t.IsEmpty() {
-		panic("BST underflow")
+		return t.entry(nil)
 	}
 
 	if !t.isRed(t.root.left) && !t.isRed(t.root.right) {
 		t.root.color = RED
 	}
 
-	t.root = t.deleteMin(t.root)
+	var x *node[K, V]
+	t.root, x = t.deleteMin(t.root)
 	if !t.IsEmpty() {
 		t.root.color = BLACK
 	}
+	return t.entry(x)
 }
 
 // deleteMin removes the node with the smallest key from the subtree rooted at h.
-func (t *OrderedMap[K, V]) deleteMin(h *node[K, V]) *node[K, V] {
+// It returns the new subtree and the removed node.
+func (t *OrderedMap[K, V]) deleteMin(h *node[K, V]) (*node[K, V], *node[K, V]) {
 	if h.left == nil {
-		return nil
+		return nil, h
 	}
 
 	if !t.isRed(h.left) && !t.isRed(h.left.left) {
 		h = t.moveRedLeft(h)
 	}
 
-	h.left = t.deleteMin(h.left)
-	return t.balance(h)
+	var x *node[K, V]
+	h.left, x = t.deleteMin(h.left)
+	return t.balance(h), x
 }
 
 // DeleteMax removes the largest key and associated value from the map.
-func (t *OrderedMap[K, V]) DeleteMax() {
+// It returns ErrEmpty if the map is empty.
+func (t *OrderedMap[K, V]) DeleteMax() error {
+	if _, _, ok := t.PopMax(); !ok {
+		return ErrEmpty
+	}
+	return nil
+}
+
+// PopMax removes the largest key from the map in a single descent and returns
+// it with its value and true. If the map is empty, it returns zero values and false.
+func (t *OrderedMap[K, V]) PopMax() (K, V, bool) {
 	if t.IsEmpty() {
-		panic("BST underflow")
+		return t.entry(nil)
 	}
 
 	if !t.isRed(t.root.left) && !t.isRed(t.root.right) {
 		t.root.color = RED
 	}
 
-	t.root = t.deleteMax(t.root)
+	var x *node[K, V]
+	t.root, x = t.deleteMax(t.root)
 	if !t.IsEmpty() {
 		t.root.color = BLACK
 	}
+	return t.entry(x)
 }
 
 // deleteMax removes the node with the largest key from the subtree rooted at h.
-func (t *OrderedMap[K, V]) deleteMax(h *node[K, V]) *node[K, V] {
+// It returns the new subtree and the removed node.
+func (t *OrderedMap[K, V]) deleteMax(h *node[K, V]) (*node[K, V], *node[K, V]) {
 	if t.isRed(h.left) {
 		h = t.rotateRight(h)
 	}
 
 	if h.right == nil {
-		return nil
+		return nil, h
 	}
 
 	if !t.isRed(h.right) && !t.isRed(h.right.left) {
 		h = t.moveRedRight(h)
 	}
 
-	h.right = t.deleteMax(h.right)
+	var x *node[K, V]
+	h.right, x = t.deleteMax(h.right)
 
-	return t.balance(h)
+	return t.balance(h), x
 }
 
 // delete removes the node with the given key from the subtree rooted at h.
-func (t *OrderedMap[K, V]) delete(h *node[K, V], key K) *node[K, V] {
-	if key < h.key {
+// Unlike the Java original it tolerates a missing key, so Delete needs no
+// separate lookup: the tree is still restructured on the way down, and balance
+// repairs it on the way up as it would after a removal.
+// It also returns the removed value and whether the key was found.
+func (t *OrderedMap[K, V]) delete(h *node[K, V], key K) (*node[K, V], V, bool) {
+	var removed V
+	var found bool
+	if t.compare(key, h.key) < 0 {
+		if h.left == nil {
+			return h, removed, false
+		}
 		if !t.isRed(h.left) && !t.isRed(h.left.left) {
 			h = t.moveRedLeft(h)
 		}
-		h.left = t.delete(h.left, key)
+		h.left, removed, found = t.delete(h.left, key)
 	} else {
 		if t.isRed(h.left) {
 			h = t.rotateRight(h)
 		}
-		if key == h.key && h.right == nil {
-			return nil
+		if h.right == nil {
+			if t.compare(key, h.key) == 0 {
+				return nil, h.val, true
+			}
+			return h, removed, false
 		}
 		if !t.isRed(h.right) && !t.isRed(h.right.left) {
 			h = t.moveRedRight(h)
 		}
-		if key == h.key {
-			x := t.min(h.right)
+		if t.compare(key, h.key) == 0 {
+			removed, found = h.val, true
+			var x *node[K, V]
+			h.right, x = t.deleteMin(h.right)
 			h.key = x.key
 			h.val = x.val
-			h.right = t.deleteMin(h.right)
 		} else {
-			h.right = t.delete(h.right, key)
+			h.right, removed, found = t.delete(h.right, key)
 		}
 	}
-	return t.balance(h)
+	return t.balance(h), removed, found
 }
 
 // moveRedLeft makes the left child or one of its children red.
@@ -354,10 +814,12 @@ func (t *OrderedMap[K, V]) keysInRange(x *node[K, V], queue *[]K, lo, hi K) {
 		return
 	}
 	// Claude had error in comparison order
-	cmplt := lo < x.key
-	cmple := lo <= x.key
-	cmpgt := hi > x.key
-	cmpge := hi >= x.key
+	cmplo := t.compare(lo, x.key)
+	cmphi := t.compare(hi, x.key)
+	cmplt := cmplo < 0
+	cmple := cmplo <= 0
+	cmpgt := cmphi > 0
+	cmpge := cmphi >= 0
 
 	if cmplt {
 		t.keysInRange(x.left, queue, lo, hi)
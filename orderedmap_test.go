@@ -1,9 +1,14 @@
 package orderedmap
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -445,3 +450,696 @@ func FuzzOrderedMap(f *testing.F) {
 		}
 	})
 }
+
+// TestFloorCeilingLowerHigher tests the navigation queries of the OrderedMap.
+//
+// It fills a map with the even numbers 0..18 and checks Floor, Ceiling, Lower and
+// Higher for keys that are present, keys that fall between two entries and keys
+// that lie outside the range of the map.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestFloorCeilingLowerHigher(t *testing.T) {
+	om := NewOrderedMap[int, string]()
+	for i := 0; i < 20; i += 2 {
+		om.Put(i, strconv.Itoa(i))
+	}
+
+	tests := []struct {
+		name  string
+		query func(int) (int, string, bool)
+		key   int
+		want  int
+		found bool
+	}{
+		{"Floor", om.Floor, 4, 4, true},
+		{"Floor", om.Floor, 5, 4, true},
+		{"Floor", om.Floor, -1, 0, false},
+		{"Floor", om.Floor, 100, 18, true},
+		{"Ceiling", om.Ceiling, 4, 4, true},
+		{"Ceiling", om.Ceiling, 5, 6, true},
+		{"Ceiling", om.Ceiling, -1, 0, true},
+		{"Ceiling", om.Ceiling, 19, 0, false},
+		{"Lower", om.Lower, 4, 2, true},
+		{"Lower", om.Lower, 5, 4, true},
+		{"Lower", om.Lower, 0, 0, false},
+		{"Higher", om.Higher, 4, 6, true},
+		{"Higher", om.Higher, 5, 6, true},
+		{"Higher", om.Higher, 18, 0, false},
+	}
+	for _, tc := range tests {
+		k, v, found := tc.query(tc.key)
+		if found != tc.found {
+			t.Errorf("%s(%d): expected found %v, got %v", tc.name, tc.key, tc.found, found)
+			continue
+		}
+		if found && (k != tc.want || v != strconv.Itoa(tc.want)) {
+			t.Errorf("%s(%d): expected %d, got %d (%q)", tc.name, tc.key, tc.want, k, v)
+		}
+	}
+
+	empty := NewOrderedMap[int, string]()
+	if _, _, found := empty.Floor(1); found {
+		t.Error("Floor on empty map should not find a key")
+	}
+}
+
+// TestRankSelect tests the order-statistic queries Rank and Select of the OrderedMap.
+//
+// It inserts the multiples of 3 below 300 in random order, then checks that Select(i)
+// returns the i-th smallest key, that Rank inverts Select, and that Rank of keys
+// that are not present counts the smaller keys. Out of range Select calls must fail.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestRankSelect(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, i := range rng.Perm(100) {
+		om.Put(i*3, i)
+	}
+
+	for i := 0; i < 100; i++ {
+		k, v, found := om.Select(i)
+		if !found || k != i*3 || v != i {
+			t.Errorf("Select(%d): expected %d/%d, got %d/%d (found %v)", i, i*3, i, k, v, found)
+		}
+		if r := om.Rank(k); r != i {
+			t.Errorf("Rank(%d): expected %d, got %d", k, i, r)
+		}
+		if r := om.Rank(k + 1); r != i+1 {
+			t.Errorf("Rank(%d): expected %d, got %d", k+1, i+1, r)
+		}
+	}
+
+	if r := om.Rank(-5); r != 0 {
+		t.Errorf("Rank(-5): expected 0, got %d", r)
+	}
+	if r := om.Rank(1000); r != 100 {
+		t.Errorf("Rank(1000): expected 100, got %d", r)
+	}
+	if _, _, found := om.Select(-1); found {
+		t.Error("Select(-1) should not succeed")
+	}
+	if _, _, found := om.Select(100); found {
+		t.Error("Select(100) should not succeed")
+	}
+}
+
+// TestCountInRange tests CountInRange and its exclusive variants against the
+// length of the slice returned by KeysInRange.
+//
+// It inserts 200 random keys and compares the counts for many random ranges, including
+// ranges whose bounds are present in the map, absent from it, or reversed.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestCountInRange(t *testing.T) {
+	om := NewOrderedMap[int, bool]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 200; i++ {
+		om.Put(rng.Intn(1000), true)
+	}
+
+	for i := 0; i < 1000; i++ {
+		lo, hi := rng.Intn(1100)-50, rng.Intn(1100)-50
+		keys := om.KeysInRange(lo, hi)
+
+		if got := om.CountInRange(lo, hi); got != len(keys) {
+			t.Errorf("CountInRange(%d, %d): expected %d, got %d", lo, hi, len(keys), got)
+		}
+
+		want := len(keys)
+		if om.Contains(hi) && lo <= hi {
+			want--
+		}
+		if got := om.CountInRangeHalfOpen(lo, hi); got != want {
+			t.Errorf("CountInRangeHalfOpen(%d, %d): expected %d, got %d", lo, hi, want, got)
+		}
+
+		if om.Contains(lo) && lo < hi {
+			want--
+		}
+		if lo == hi {
+			want = 0
+		}
+		if got := om.CountInRangeOpen(lo, hi); got != want {
+			t.Errorf("CountInRangeOpen(%d, %d): expected %d, got %d", lo, hi, want, got)
+		}
+	}
+}
+
+// tenantKey is a composite key used to test maps created with NewOrderedMapFunc.
+type tenantKey struct {
+	tenant int
+	ts     time.Time
+}
+
+// compareTenantKey orders tenantKeys by tenant and then by timestamp.
+func compareTenantKey(a, b tenantKey) int {
+	if a.tenant != b.tenant {
+		if a.tenant < b.tenant {
+			return -1
+		}
+		return 1
+	}
+	return a.ts.Compare(b.ts)
+}
+
+// TestNewOrderedMapFunc tests an OrderedMap with struct keys ordered by a custom
+// comparison function.
+//
+// It inserts keys for several tenants in random order and checks Get, the
+// iteration order, range queries, navigation queries and deletion, validating
+// the tree structure along the way. A nil comparison function must panic.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestNewOrderedMapFunc(t *testing.T) {
+	om := NewOrderedMapFunc[tenantKey, int](compareTenantKey)
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, i := range rng.Perm(300) {
+		om.Put(tenantKey{i % 3, base.Add(time.Duration(i) * time.Minute)}, i)
+	}
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if om.Size() != 300 {
+		t.Fatalf("Expected size 300, got %d", om.Size())
+	}
+
+	if v, found := om.Get(tenantKey{1, base.Add(4 * time.Minute)}); !found || v != 4 {
+		t.Errorf("Get: expected 4, got %d (found %v)", v, found)
+	}
+
+	var prev *tenantKey
+	for k, v := range om.All() {
+		if prev != nil && compareTenantKey(*prev, k) >= 0 {
+			t.Fatalf("keys not in order: %v, %v", *prev, k)
+		}
+		if v%3 != k.tenant {
+			t.Errorf("unexpected value %d for tenant %d", v, k.tenant)
+		}
+		prev = &k
+	}
+
+	lo := tenantKey{tenant: 1}
+	hi := tenantKey{tenant: 2}
+	if n := om.CountBetween(Inclusive(lo), Exclusive(hi)); n != 100 {
+		t.Errorf("CountBetween: expected 100 keys for tenant 1, got %d", n)
+	}
+	if k, v, found := om.Ceiling(lo); !found || k.tenant != 1 || v != 1 {
+		t.Errorf("Ceiling: expected the first key of tenant 1, got %v=%d", k, v)
+	}
+
+	for _, k := range om.KeysInRange(lo, hi) {
+		om.Delete(k)
+	}
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if om.Size() != 200 {
+		t.Errorf("Expected size 200 after deleting tenant 1, got %d", om.Size())
+	}
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "nil comparison function") {
+			t.Errorf("NewOrderedMapFunc(nil): expected a panic, got %v", r)
+		}
+	}()
+	NewOrderedMapFunc[tenantKey, int](nil)
+}
+
+// TestFloatKeys tests that float64 keys follow the total order of cmp.Compare.
+//
+// NaN must sort before every other key and be found again by Get, putting NaN must
+// not overwrite any other entry, and -0 and +0 must be treated as the same key.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestFloatKeys(t *testing.T) {
+	om := NewOrderedMap[float64, string]()
+	for _, k := range []float64{3, 1, math.Inf(1), -2, math.Inf(-1), 0.5} {
+		om.Put(k, fmt.Sprint(k))
+	}
+	om.Put(math.NaN(), "NaN")
+	om.Put(math.NaN(), "NaN again")
+	om.Put(math.Copysign(0, -1), "-0")
+
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if om.Size() != 8 {
+		t.Fatalf("Expected size 8, got %d", om.Size())
+	}
+	for _, k := range []float64{3, 1, math.Inf(1), -2, math.Inf(-1), 0.5} {
+		if v, found := om.Get(k); !found || v != fmt.Sprint(k) {
+			t.Errorf("Get(%v): expected %q, got %q (found %v)", k, fmt.Sprint(k), v, found)
+		}
+	}
+	if v, found := om.Get(math.NaN()); !found || v != "NaN again" {
+		t.Errorf("Get(NaN): expected %q, got %q (found %v)", "NaN again", v, found)
+	}
+	if k, _ := om.Min(); !math.IsNaN(k) {
+		t.Errorf("Min: expected NaN, got %v", k)
+	}
+
+	om.Put(0, "+0")
+	if om.Size() != 8 {
+		t.Errorf("+0 and -0 should be the same key, got size %d", om.Size())
+	}
+	if v, _ := om.Get(math.Copysign(0, -1)); v != "+0" {
+		t.Errorf("Get(-0): expected %q, got %q", "+0", v)
+	}
+
+	om.Delete(math.NaN())
+	if om.Contains(math.NaN()) || om.Size() != 7 {
+		t.Errorf("Delete(NaN) failed, size %d", om.Size())
+	}
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// FuzzOrderedMapFloat64 is a fuzz testing function for float64 keys, including NaN
+// and signed zero.
+//
+// For each pair of keys it puts both into a fresh map and checks that each key can
+// be found with its own value unless the two keys compare equal, and that deleting
+// one key leaves the map valid and removes exactly one entry.
+//
+// Parameters:
+// - f: a pointer to a *testing.F object.
+//
+// Return type: None.
+func FuzzOrderedMapFloat64(f *testing.F) {
+	f.Add(1.5, 2.5)
+	f.Add(math.NaN(), 1.0)
+	f.Add(math.Copysign(0, -1), 0.0)
+	f.Add(math.Inf(-1), math.NaN())
+
+	f.Fuzz(func(t *testing.T, a, b float64) {
+		om := NewOrderedMap[float64, string]()
+		om.Put(a, "a")
+		om.Put(b, "b")
+		same := a == b || (math.IsNaN(a) && math.IsNaN(b))
+
+		if v, found := om.Get(b); !found || v != "b" {
+			t.Errorf("Get(%v): expected %q, got %q (found %v)", b, "b", v, found)
+		}
+		if !same {
+			if v, found := om.Get(a); !found || v != "a" {
+				t.Errorf("Get(%v): expected %q, got %q (found %v)", a, "a", v, found)
+			}
+		}
+
+		om.Delete(a)
+		if err := om.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if om.Contains(a) {
+			t.Errorf("Key %v found in map after deletion", a)
+		}
+		if want := map[bool]int{true: 0, false: 1}[same]; om.Size() != want {
+			t.Errorf("Expected size %d, got %d", want, om.Size())
+		}
+	})
+}
+
+// TestOrderedFastPath tests that a map created by NewOrderedMap, which compares
+// keys directly, behaves exactly like a map created by NewOrderedMapFunc with
+// cmp.Compare under random Put, Get, Compute and Delete calls on float keys that
+// include NaN and both zeros.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestOrderedFastPath(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	special := []float64{math.NaN(), math.Copysign(0, -1), 0, math.Inf(1), math.Inf(-1)}
+	fast := NewOrderedMap[float64, int]()
+	slow := NewOrderedMapFunc[float64, int](cmp.Compare[float64])
+
+	for i := 0; i < 20000; i++ {
+		k := float64(rng.Intn(200))
+		if rng.Intn(10) == 0 {
+			k = special[rng.Intn(len(special))]
+		}
+		var got, want [2]any
+		switch rng.Intn(4) {
+		case 0:
+			got[0], got[1] = fast.Put(k, i)
+			want[0], want[1] = slow.Put(k, i)
+		case 1:
+			got[0], got[1] = fast.Get(k)
+			want[0], want[1] = slow.Get(k)
+		case 2:
+			drop := func(old int, exists bool) (int, bool) { return old + 1, old%2 == 0 }
+			got[0], got[1] = fast.Compute(k, drop)
+			want[0], want[1] = slow.Compute(k, drop)
+		default:
+			got[0], got[1] = fast.Delete(k)
+			want[0], want[1] = slow.Delete(k)
+		}
+		if got != want {
+			t.Fatalf("operation %d on key %v: fast path returned %v, expected %v", i, k, got, want)
+		}
+	}
+
+	if err := fast.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(fast.Keys()) != fmt.Sprint(slow.Keys()) {
+		t.Errorf("fast path keys %v differ from %v", fast.Keys(), slow.Keys())
+	}
+}
+
+// TestGetOrPutUpdateCompute tests the read-modify-write operations GetOrPut, Update
+// and Compute against a built-in map, validating the tree after every operation.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestGetOrPutUpdateCompute(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	ref := make(map[int]int)
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for i := 0; i < 5000; i++ {
+		k := rng.Intn(300)
+		old, exists := ref[k]
+		switch rng.Intn(4) {
+		case 0:
+			v, found := om.GetOrPut(k, i)
+			if found != exists || (exists && v != old) || (!exists && v != i) {
+				t.Fatalf("GetOrPut(%d): got %d, %v; expected %d, %v", k, v, found, old, exists)
+			}
+			if !exists {
+				ref[k] = i
+			}
+		case 1:
+			v := om.Update(k, func(v int, ok bool) int {
+				if ok != exists || v != old {
+					t.Fatalf("Update(%d): fn called with %d, %v; expected %d, %v", k, v, ok, old, exists)
+				}
+				return v + 1
+			})
+			if v != old+1 {
+				t.Fatalf("Update(%d): expected %d, got %d", k, old+1, v)
+			}
+			ref[k] = old + 1
+		case 2:
+			// counters that disappear when they reach an even value
+			v, kept := om.Compute(k, func(v int, ok bool) (int, bool) {
+				return v + 1, (v+1)%2 == 1
+			})
+			if kept {
+				ref[k] = v
+			} else {
+				delete(ref, k)
+			}
+		case 3:
+			om.Delete(k)
+			delete(ref, k)
+		}
+
+		if err := om.Validate(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		if om.Size() != len(ref) {
+			t.Fatalf("step %d: expected size %d, got %d", i, len(ref), om.Size())
+		}
+	}
+	for k, want := range ref {
+		if v, found := om.Get(k); !found || v != want {
+			t.Errorf("Get(%d): expected %d, got %d (found %v)", k, want, v, found)
+		}
+	}
+
+	empty := NewOrderedMap[int, int]()
+	if _, kept := empty.Compute(1, func(int, bool) (int, bool) { return 0, false }); kept || !empty.IsEmpty() {
+		t.Error("Compute dropping a missing key must not insert it")
+	}
+	empty.Delete(1)
+}
+
+// TestComputeRemove tests that Compute removes keys in a single descent: dropping
+// a key must compare exactly as many keys as looking it up, and the tree must stay
+// valid while a large map is emptied this way.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestComputeRemove(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	comparisons := 0
+	om := NewOrderedMapFunc[int, int](func(a, b int) int {
+		comparisons++
+		return cmp.Compare(a, b)
+	})
+	keys := rng.Perm(2000)
+	for _, k := range keys {
+		om.Put(k, k)
+	}
+
+	drop := func(int, bool) (int, bool) { return 0, false }
+	for i, k := range keys {
+		comparisons = 0
+		om.Get(k)
+		lookup := comparisons
+
+		comparisons = 0
+		if _, kept := om.Compute(k, drop); kept {
+			t.Fatalf("Compute(%d): key kept after drop", k)
+		}
+		if comparisons != lookup {
+			t.Fatalf("Compute(%d): %d comparisons to remove, %d to look up", k, comparisons, lookup)
+		}
+		if om.Contains(k) || om.Size() != len(keys)-i-1 {
+			t.Fatalf("Compute(%d): key not removed", k)
+		}
+		if i%50 == 0 {
+			if err := om.Validate(); err != nil {
+				t.Fatalf("after removing %d keys: %v", i+1, err)
+			}
+		}
+	}
+}
+
+// TestPutDeleteReport tests that Put reports the previous value and whether the
+// key existed, and that Delete returns the removed value and a found flag.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestPutDeleteReport(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+
+	if old, existed := om.Put("a", 1); existed || old != 0 {
+		t.Errorf("Put(a, 1): expected 0, false; got %d, %v", old, existed)
+	}
+	om.Put("b", 2)
+	if old, existed := om.Put("a", 10); !existed || old != 1 {
+		t.Errorf("Put(a, 10): expected 1, true; got %d, %v", old, existed)
+	}
+
+	if v, found := om.Delete("a"); !found || v != 10 {
+		t.Errorf("Delete(a): expected 10, true; got %d, %v", v, found)
+	}
+	if v, found := om.Delete("a"); found || v != 0 {
+		t.Errorf("Delete(a) again: expected 0, false; got %d, %v", v, found)
+	}
+	if v, found := om.Delete("b"); !found || v != 2 {
+		t.Errorf("Delete(b): expected 2, true; got %d, %v", v, found)
+	}
+	if _, found := om.Delete("b"); found || !om.IsEmpty() {
+		t.Error("Delete on an empty map should report not found")
+	}
+
+	// removing an inner node must report its own value, not its successor's
+	for i := 0; i < 100; i++ {
+		om.Put(strconv.Itoa(i), i)
+	}
+	for i := 0; i < 100; i += 3 {
+		if v, found := om.Delete(strconv.Itoa(i)); !found || v != i {
+			t.Errorf("Delete(%d): expected %d, true; got %d, %v", i, i, v, found)
+		}
+	}
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestPopMinMax tests PopMin and PopMax used as a double-ended priority queue, and
+// that DeleteMin and DeleteMax return ErrEmpty instead of panicking on an empty map.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestPopMinMax(t *testing.T) {
+	om := NewOrderedMap[int, string]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, i := range rng.Perm(200) {
+		om.Put(i, strconv.Itoa(i))
+	}
+
+	lo, hi := 0, 199
+	for !om.IsEmpty() {
+		var k int
+		var v string
+		var ok bool
+		if rng.Intn(2) == 0 {
+			k, v, ok = om.PopMin()
+			if k != lo {
+				t.Fatalf("PopMin: expected %d, got %d", lo, k)
+			}
+			lo++
+		} else {
+			k, v, ok = om.PopMax()
+			if k != hi {
+				t.Fatalf("PopMax: expected %d, got %d", hi, k)
+			}
+			hi--
+		}
+		if !ok || v != strconv.Itoa(k) {
+			t.Fatalf("Pop: expected value %q, got %q (ok %v)", strconv.Itoa(k), v, ok)
+		}
+		if err := om.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, ok := om.PopMin(); ok {
+		t.Error("PopMin on an empty map should fail")
+	}
+	if _, _, ok := om.PopMax(); ok {
+		t.Error("PopMax on an empty map should fail")
+	}
+	if err := om.DeleteMin(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("DeleteMin: expected ErrEmpty, got %v", err)
+	}
+	if err := om.DeleteMax(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("DeleteMax: expected ErrEmpty, got %v", err)
+	}
+
+	om.Put(1, "one")
+	if err := om.DeleteMax(); err != nil || !om.IsEmpty() {
+		t.Errorf("DeleteMax: expected the only entry to be removed, got %v", err)
+	}
+}
+
+// TestZeroValue tests that the zero value of OrderedMap is a usable empty map
+// ordered by the natural order of its key type, including named key types, and
+// that it panics with a clear message for key types without a natural order.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestZeroValue(t *testing.T) {
+	var om OrderedMap[int, string]
+	if !om.IsEmpty() || om.Contains(1) || om.DeleteMin() != ErrEmpty {
+		t.Fatal("zero value is not an empty map")
+	}
+	for _, k := range []int{5, -3, 9, 0, 7, -3} {
+		om.Put(k, strconv.Itoa(k))
+	}
+	om.Delete(9)
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := om.Keys(); !slices.Equal(got, []int{-3, 0, 5, 7}) {
+		t.Errorf("zero value: expected keys [-3 0 5 7], got %v", got)
+	}
+	if v, found := om.Get(7); !found || v != "7" {
+		t.Errorf("zero value: Get(7) returned %q, %v", v, found)
+	}
+
+	type name string
+	var names OrderedMap[name, int]
+	for i, k := range []name{"carol", "alice", "bob"} {
+		names.Put(k, i)
+	}
+	if got := names.Keys(); !slices.Equal(got, []name{"alice", "bob", "carol"}) {
+		t.Errorf("zero value with a named string key: unexpected keys %v", got)
+	}
+
+	var floats OrderedMap[float32, int]
+	for i, k := range []float32{1, float32(math.NaN()), -1, float32(math.Inf(-1))} {
+		floats.Put(k, i)
+	}
+	if k, _ := floats.Min(); !math.IsNaN(float64(k)) || floats.Size() != 4 {
+		t.Errorf("zero value with float keys: expected NaN first, got %v", floats.Keys())
+	}
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "NewOrderedMapFunc") {
+			t.Errorf("zero value with a struct key: expected a panic naming NewOrderedMapFunc, got %v", r)
+		}
+	}()
+	var structs OrderedMap[struct{ a, b int }, int]
+	structs.Put(struct{ a, b int }{1, 2}, 0)
+}
+
+// benchmarkMaps returns a map created by NewOrderedMap and one created by
+// NewOrderedMapFunc, both holding the keys 0 to n-1, and the keys in random order.
+func benchmarkMaps(n int) (map[string]*OrderedMap[int, int], []int) {
+	keys := rand.New(rand.NewSource(1)).Perm(n)
+	maps := map[string]*OrderedMap[int, int]{
+		"Ordered": NewOrderedMap[int, int](),
+		"Func":    NewOrderedMapFunc[int, int](func(a, b int) int { return a - b }),
+	}
+	for _, om := range maps {
+		for _, k := range keys {
+			om.Put(k, k)
+		}
+	}
+	return maps, keys
+}
+
+// BenchmarkGet measures Get on 64k int keys. The Ordered case guards the fast path
+// of NewOrderedMap, which compares keys directly instead of calling a comparison
+// function, and should stay clearly faster than the Func case.
+func BenchmarkGet(b *testing.B) {
+	maps, keys := benchmarkMaps(1 << 16)
+	for _, name := range []string{"Ordered", "Func"} {
+		om := maps[name]
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				om.Get(keys[i&(len(keys)-1)])
+			}
+		})
+	}
+}
+
+// BenchmarkPutDelete measures a Delete followed by a Put of the same key on 64k
+// int keys, for both kinds of map.
+func BenchmarkPutDelete(b *testing.B) {
+	maps, keys := benchmarkMaps(1 << 16)
+	for _, name := range []string{"Ordered", "Func"} {
+		om := maps[name]
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				k := keys[i&(len(keys)-1)]
+				om.Delete(k)
+				om.Put(k, k)
+			}
+		})
+	}
+}
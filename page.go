@@ -0,0 +1,112 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidPageToken is returned by NextPage when the token cannot be decoded.
+var ErrInvalidPageToken = errors.New("orderedmap: invalid page token")
+
+// ErrInvalidPageLimit is returned by NextPage when the page limit is not positive.
+var ErrInvalidPageLimit = errors.New("orderedmap: page limit must be positive")
+
+// pageToken is the value encoded in a page token. Wrapping the key in a struct
+// lets gob encode nil pointer keys, such as the nil *big.Int of NewBigIntMap,
+// which it refuses to encode as a top-level value.
+type pageToken[K any] struct {
+	Key K
+}
+
+// Page returns up to limit key-value pairs in ascending key order, starting at the
+// entry with rank offset. Subtree sizes are used to reach the offset in O(log n),
+// so the cost of a page does not depend on how far into the map it is.
+func (t *OrderedMap[K, V]) Page(offset, limit int) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0)
+	if offset < 0 || limit <= 0 {
+		return entries
+	}
+	c := t.Cursor()
+	for ok := c.seekRank(offset); ok && len(entries) < limit; ok = c.Next() {
+		entries = append(entries, Entry[K, V]{Key: c.Key(), Value: c.Value()})
+	}
+	return entries
+}
+
+// PageAfter returns up to limit key-value pairs with keys strictly greater than key,
+// in ascending key order. Unlike Page, the result does not shift when entries
+// before key are inserted or deleted between calls.
+func (t *OrderedMap[K, V]) PageAfter(key K, limit int) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0)
+	if limit <= 0 {
+		return entries
+	}
+	t.ascend(t.between(Exclusive(key), Unbounded[K]()), func(x *node[K, V]) bool {
+		entries = append(entries, Entry[K, V]{Key: x.key, Value: x.val})
+		return len(entries) < limit
+	})
+	return entries
+}
+
+// NextPage returns up to limit key-value pairs following the position encoded in
+// token, together with the token for the next page. An empty token starts at the
+// smallest key, and an empty next token means there are no more entries.
+// NextPage returns ErrInvalidPageLimit if limit is not positive.
+//
+// The token records the last key returned, so a scan resumes correctly even if
+// the map changed in between. Keys are encoded with encoding/gob, so K must be
+// gob-encodable.
+func (t *OrderedMap[K, V]) NextPage(token string, limit int) ([]Entry[K, V], string, error) {
+	if limit <= 0 {
+		return nil, "", fmt.Errorf("%w: %d", ErrInvalidPageLimit, limit)
+	}
+
+	var entries []Entry[K, V]
+	if token == "" {
+		entries = t.Page(0, limit)
+	} else {
+		key, err := decodePageToken[K](token)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = t.PageAfter(key, limit)
+	}
+
+	if len(entries) < limit {
+		return entries, "", nil
+	}
+	last := entries[len(entries)-1].Key
+	if t.higher(t.root, last) == nil {
+		return entries, "", nil
+	}
+	next, err := encodePageToken(last)
+	if err != nil {
+		return nil, "", err
+	}
+	return entries, next, nil
+}
+
+// encodePageToken encodes key into an opaque, URL-safe page token.
+func encodePageToken[K any](key K) (string, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(pageToken[K]{Key: key}); err != nil {
+		return "", fmt.Errorf("orderedmap: encoding page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// decodePageToken decodes a key from a page token made by encodePageToken.
+func decodePageToken[K any](token string) (K, error) {
+	var tok pageToken[K]
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return tok.Key, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&tok); err != nil {
+		return tok.Key, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+	return tok.Key, nil
+}
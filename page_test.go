@@ -0,0 +1,111 @@
+package orderedmap
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+// TestPage tests offset/limit pagination with Page and keyset pagination with
+// PageAfter against slices of Keys.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestPage(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	for i := 0; i < 95; i++ {
+		om.Put(i*2, i)
+	}
+	keys := om.Keys()
+
+	for offset := 0; offset < 100; offset += 7 {
+		page := om.Page(offset, 10)
+		want := keys[min(offset, len(keys)):min(offset+10, len(keys))]
+		if len(page) != len(want) {
+			t.Fatalf("Page(%d, 10): expected %d entries, got %d", offset, len(want), len(page))
+		}
+		for i, e := range page {
+			if e.Key != want[i] || e.Value != want[i]/2 {
+				t.Errorf("Page(%d, 10): expected key %d at %d, got %v", offset, want[i], i, e)
+			}
+		}
+	}
+	if len(om.Page(-1, 10)) != 0 || len(om.Page(0, 0)) != 0 {
+		t.Error("Page with a negative offset or zero limit should be empty")
+	}
+
+	page := om.PageAfter(41, 3)
+	if got := []int{page[0].Key, page[1].Key, page[2].Key}; !slices.Equal(got, []int{42, 44, 46}) {
+		t.Errorf("PageAfter(41, 3): expected [42 44 46], got %v", got)
+	}
+	page = om.PageAfter(42, 3)
+	if page[0].Key != 44 {
+		t.Errorf("PageAfter(42, 3): expected to start at 44, got %d", page[0].Key)
+	}
+	if n := len(om.PageAfter(186, 3)); n != 1 {
+		t.Errorf("PageAfter(186, 3): expected 1 entry, got %d", n)
+	}
+}
+
+// TestNextPage tests that a scan driven by NextPage tokens visits every key exactly
+// once while the map is modified between pages, and that a corrupt token is rejected.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestNextPage(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	for i := 0; i < 50; i++ {
+		om.Put(string(rune('A'+i)), i)
+	}
+
+	var seen []string
+	token := ""
+	for pages := 0; ; pages++ {
+		entries, next, err := om.NextPage(token, 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range entries {
+			seen = append(seen, e.Key)
+		}
+		if next == "" {
+			break
+		}
+		// delete the entry the token points at and insert before the cursor
+		om.Delete(entries[len(entries)-1].Key)
+		om.Put(string(rune('A'-1-pages)), -1)
+		token = next
+	}
+
+	if len(seen) != 50 || !slices.IsSorted(seen) {
+		t.Errorf("expected 50 sorted keys, got %d: %v", len(seen), seen)
+	}
+
+	if _, _, err := om.NextPage("not a token!", 8); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+	for _, limit := range []int{0, -1} {
+		if _, _, err := om.NextPage("", limit); !errors.Is(err, ErrInvalidPageLimit) {
+			t.Errorf("NextPage with limit %d: expected ErrInvalidPageLimit, got %v", limit, err)
+		}
+	}
+
+	// a nil *big.Int is a valid key and must survive the round trip through a token
+	bm := NewBigIntMap[int]()
+	bm.Put(nil, 0)
+	for i := int64(1); i <= 3; i++ {
+		bm.Put(big.NewInt(i), int(i))
+	}
+	entries, next, err := bm.NextPage("", 1)
+	if err != nil || len(entries) != 1 || entries[0].Key != nil || next == "" {
+		t.Fatalf("NextPage of a map starting with a nil key: %v, %q, %v", entries, next, err)
+	}
+	if entries, _, err = bm.NextPage(next, 10); err != nil || len(entries) != 3 {
+		t.Errorf("NextPage after a nil key: expected 3 entries, got %v, %v", entries, err)
+	}
+}
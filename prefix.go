@@ -0,0 +1,38 @@
+package orderedmap
+
+import (
+	"iter"
+	"strings"
+)
+
+// PrefixScan returns an iterator over the entries of m whose key starts with prefix,
+// in ascending key order. It seeks to prefix and stops at the first key that no
+// longer has it. m must be ordered by the natural byte-wise string order, as
+// created by NewOrderedMap.
+func PrefixScan[V any](m *OrderedMap[string, V], prefix string) iter.Seq2[string, V] {
+	return m.RangeFunc(hasPrefix(prefix))
+}
+
+// PrefixCount returns the number of keys of m that start with prefix.
+// It runs in O(log n). See PrefixScan for the ordering requirement.
+func PrefixCount[V any](m *OrderedMap[string, V], prefix string) int {
+	return m.CountFunc(hasPrefix(prefix))
+}
+
+// PrefixDelete removes every entry of m whose key starts with prefix and returns
+// the number of removed entries. See PrefixScan for the ordering requirement.
+func PrefixDelete[V any](m *OrderedMap[string, V], prefix string) int {
+	return m.DeleteRangeFunc(hasPrefix(prefix))
+}
+
+// hasPrefix returns the position function for the strings that start with prefix.
+// All strings below prefix sort before the prefixed ones and all other strings
+// above prefix sort after them.
+func hasPrefix(prefix string) func(string) int {
+	return func(key string) int {
+		if strings.HasPrefix(key, prefix) {
+			return 0
+		}
+		return strings.Compare(key, prefix)
+	}
+}
@@ -0,0 +1,61 @@
+package orderedmap
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestPrefixScan tests PrefixScan, PrefixCount and PrefixDelete against a linear
+// filter of Keys, using prefixes that contain multi-byte UTF-8 characters and
+// prefixes that end in the largest byte values.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestPrefixScan(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	keys := []string{
+		"/users/4", "/users/42", "/users/42/", "/users/42/name", "/users/42/zoë",
+		"/users/420", "/users/43", "/users/42\xff", "/users/42\xff\xff",
+		"/groups/42", "", "/", "/usersX", "zoë", "zoë/ä", "zoë/ö", "zoe",
+	}
+	for i, k := range keys {
+		om.Put(k, i)
+	}
+
+	for _, prefix := range []string{"/users/42/", "/users/42", "/users/", "zoë", "zoë/", "/users/42\xff", "", "nope"} {
+		want := []string{}
+		for _, k := range om.Keys() {
+			if strings.HasPrefix(k, prefix) {
+				want = append(want, k)
+			}
+		}
+
+		got := []string{}
+		for k, v := range PrefixScan(om, prefix) {
+			if keys[v] != k {
+				t.Errorf("PrefixScan(%q): wrong value %d for key %q", prefix, v, k)
+			}
+			got = append(got, k)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("PrefixScan(%q): expected %q, got %q", prefix, want, got)
+		}
+		if n := PrefixCount(om, prefix); n != len(want) {
+			t.Errorf("PrefixCount(%q): expected %d, got %d", prefix, len(want), n)
+		}
+	}
+
+	size := om.Size()
+	if n := PrefixDelete(om, "/users/42"); n != 7 {
+		t.Errorf("PrefixDelete: expected 7 removed entries, got %d", n)
+	}
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if om.Size() != size-7 || PrefixCount(om, "/users/42") != 0 || !om.Contains("/users/43") {
+		t.Errorf("PrefixDelete removed the wrong entries: %q", om.Keys())
+	}
+}
@@ -0,0 +1,84 @@
+package orderedmap
+
+import "math/bits"
+
+// Union returns a new OrderedMap holding the entries of a and b. For a key present
+// in both maps the value is resolve(key, va, vb). The maps are merged in one pass
+// and the result is bulk built, so Union runs in O(n+m). The result uses the
+// ordering of a; a and b are not changed.
+func Union[K, V any](a, b *OrderedMap[K, V], resolve func(key K, va, vb V) V) *OrderedMap[K, V] {
+	return combine(a, b, true, true, func(key K, va, vb V) (V, bool) {
+		return resolve(key, va, vb), true
+	})
+}
+
+// Intersection returns a new OrderedMap holding the keys present in both a and b,
+// each with the value resolve(key, va, vb). It runs in O(n+m) and uses the
+// ordering of a.
+func Intersection[K, V any](a, b *OrderedMap[K, V], resolve func(key K, va, vb V) V) *OrderedMap[K, V] {
+	return combine(a, b, false, false, func(key K, va, vb V) (V, bool) {
+		return resolve(key, va, vb), true
+	})
+}
+
+// Difference returns a new OrderedMap holding the entries of a whose keys are not
+// present in b. It runs in O(n+m) and uses the ordering of a.
+func Difference[K, V any](a, b *OrderedMap[K, V]) *OrderedMap[K, V] {
+	return combine(a, b, true, false, nil)
+}
+
+// SymmetricDifference returns a new OrderedMap holding the entries whose keys are
+// present in exactly one of a and b. It runs in O(n+m) and uses the ordering of a.
+func SymmetricDifference[K, V any](a, b *OrderedMap[K, V]) *OrderedMap[K, V] {
+	return combine(a, b, true, true, nil)
+}
+
+// combine merges the entries of a and b in key order and bulk builds the result.
+// Keys only in a are kept if keepA is set, keys only in b if keepB is set. For keys
+// in both maps, both returns the value and whether to keep the key; a nil both
+// drops them. The entries of b are sorted by the ordering of a first, which costs
+// only a linear check when both maps are ordered the same way.
+func combine[K, V any](a, b *OrderedMap[K, V], keepA, keepB bool, both func(key K, va, vb V) (V, bool)) *OrderedMap[K, V] {
+	t := a.empty()
+	all := Unbounded[K]()
+	ea := a.AppendEntriesBetween(make([]Entry[K, V], 0, a.Size()), all, all)
+	eb := t.sortEntries(b.AppendEntriesBetween(make([]Entry[K, V], 0, b.Size()), all, all))
+
+	out := make([]Entry[K, V], 0, len(ea)+len(eb))
+	i, j := 0, 0
+	for i < len(ea) && j < len(eb) {
+		switch c := t.compare(ea[i].Key, eb[j].Key); {
+		case c < 0:
+			if keepA {
+				out = append(out, ea[i])
+			}
+			i++
+		case c > 0:
+			if keepB {
+				out = append(out, Entry[K, V]{Key: t.ownKey(eb[j].Key), Value: eb[j].Value})
+			}
+			j++
+		default:
+			if both != nil {
+				if v, keep := both(ea[i].Key, ea[i].Value, eb[j].Value); keep {
+					out = append(out, Entry[K, V]{Key: ea[i].Key, Value: v})
+				}
+			}
+			i++
+			j++
+		}
+	}
+	if keepA {
+		out = append(out, ea[i:]...)
+	}
+	if keepB {
+		for _, e := range eb[j:] {
+			out = append(out, Entry[K, V]{Key: t.ownKey(e.Key), Value: e.Value})
+		}
+	}
+
+	// the keys of a are shared like Clone shares them; only the keys of b were
+	// copied above
+	t.root = t.build(out, bits.Len(uint(len(out)+1))-1, false)
+	return t
+}
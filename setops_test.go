@@ -0,0 +1,99 @@
+package orderedmap
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestSetOperations tests Union, Intersection, Difference and SymmetricDifference
+// on random maps against the same operations on built-in maps, and validates the
+// tree structure of every result. Keys taken from a map that copies its keys must
+// not be copied again unless they come from b.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestSetOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sum := func(_ int, va, vb int) int { return va + vb }
+
+	for i := 0; i < 200; i++ {
+		ma, mb := make(map[int]int), make(map[int]int)
+		for j := rng.Intn(300); j > 0; j-- {
+			ma[rng.Intn(500)] = rng.Intn(100)
+		}
+		for j := rng.Intn(300); j > 0; j-- {
+			mb[rng.Intn(500)] = rng.Intn(100)
+		}
+		a, b := FromMap(ma), FromMap(mb)
+
+		union, inter := maps.Clone(ma), make(map[int]int)
+		diff, sym := make(map[int]int), make(map[int]int)
+		for k, va := range ma {
+			if vb, found := mb[k]; found {
+				union[k] = va + vb
+				inter[k] = va + vb
+			} else {
+				diff[k] = va
+				sym[k] = va
+			}
+		}
+		for k, vb := range mb {
+			if _, found := ma[k]; !found {
+				union[k] = vb
+				sym[k] = vb
+			}
+		}
+
+		for _, tc := range []struct {
+			name string
+			got  *OrderedMap[int, int]
+			want map[int]int
+		}{
+			{"Union", Union(a, b, sum), union},
+			{"Intersection", Intersection(a, b, sum), inter},
+			{"Difference", Difference(a, b), diff},
+			{"SymmetricDifference", SymmetricDifference(a, b), sym},
+		} {
+			if err := tc.got.Validate(); err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			if got := ToMap(tc.got); !maps.Equal(got, tc.want) {
+				t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, got)
+			}
+		}
+		if !maps.Equal(ToMap(a), ma) || !maps.Equal(ToMap(b), mb) {
+			t.Fatal("set operation changed its operands")
+		}
+	}
+
+	desc := NewOrderedMapFunc[int, int](func(a, b int) int { return b - a })
+	for k := 0; k < 10; k++ {
+		desc.Put(k, k)
+	}
+	asc := FromMap(map[int]int{3: 0, 20: 0})
+	if got := Union(asc, desc, sum).Keys(); len(got) != 11 || got[0] != 0 || got[10] != 20 {
+		t.Errorf("Union with a differently ordered map: unexpected keys %v", got)
+	}
+
+	copies := 0
+	ba, bb := NewBytesMap[int](), NewBytesMap[int]()
+	ba.copyKey = func(k []byte) []byte {
+		copies++
+		return slices.Clone(k)
+	}
+	for _, k := range []string{"a", "b", "c"} {
+		ba.Put([]byte(k), 1)
+	}
+	for _, k := range []string{"b", "d"} {
+		bb.Put([]byte(k), 2)
+	}
+	copies = 0
+	if got := Union(ba, bb, func(_ []byte, va, vb int) int { return va + vb }); got.Size() != 4 || copies != 1 {
+		t.Errorf("Union of bytes maps: expected 4 keys and 1 key copy, got %d and %d", got.Size(), copies)
+	}
+}
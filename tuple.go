@@ -0,0 +1,77 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Pair is a two-component key ordered lexicographically: first by First, then by Second.
+type Pair[A, B constraints.Ordered] struct {
+	First  A
+	Second B
+}
+
+// Triple is a three-component key ordered lexicographically by First, Second and Third.
+type Triple[A, B, C constraints.Ordered] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// ComparePair compares two Pairs lexicographically.
+func ComparePair[A, B constraints.Ordered](x, y Pair[A, B]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Second, y.Second)
+}
+
+// CompareTriple compares two Triples lexicographically.
+func CompareTriple[A, B, C constraints.Ordered](x, y Triple[A, B, C]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Third, y.Third)
+}
+
+// NewPairMap creates and returns a new empty OrderedMap keyed by Pairs.
+func NewPairMap[A, B constraints.Ordered, V any]() *OrderedMap[Pair[A, B], V] {
+	return NewOrderedMapFunc[Pair[A, B], V](ComparePair[A, B])
+}
+
+// NewTripleMap creates and returns a new empty OrderedMap keyed by Triples.
+func NewTripleMap[A, B, C constraints.Ordered, V any]() *OrderedMap[Triple[A, B, C], V] {
+	return NewOrderedMapFunc[Triple[A, B, C], V](CompareTriple[A, B, C])
+}
+
+// PairsWithFirst returns an iterator over the entries of m whose key has the given
+// first component, in ascending key order.
+func PairsWithFirst[A, B constraints.Ordered, V any](m *OrderedMap[Pair[A, B], V], first A) iter.Seq2[Pair[A, B], V] {
+	return m.RangeFunc(func(k Pair[A, B]) int {
+		return cmp.Compare(k.First, first)
+	})
+}
+
+// TriplesWithFirst returns an iterator over the entries of m whose key has the
+// given first component, in ascending key order.
+func TriplesWithFirst[A, B, C constraints.Ordered, V any](m *OrderedMap[Triple[A, B, C], V], first A) iter.Seq2[Triple[A, B, C], V] {
+	return m.RangeFunc(func(k Triple[A, B, C]) int {
+		return cmp.Compare(k.First, first)
+	})
+}
+
+// TriplesWithFirstTwo returns an iterator over the entries of m whose key has the
+// given first and second components, in ascending key order.
+func TriplesWithFirstTwo[A, B, C constraints.Ordered, V any](m *OrderedMap[Triple[A, B, C], V], first A, second B) iter.Seq2[Triple[A, B, C], V] {
+	return m.RangeFunc(func(k Triple[A, B, C]) int {
+		if c := cmp.Compare(k.First, first); c != 0 {
+			return c
+		}
+		return cmp.Compare(k.Second, second)
+	})
+}
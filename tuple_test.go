@@ -0,0 +1,106 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestPairMap tests an OrderedMap keyed by Pairs: lexicographic order, numeric
+// components that sort by value rather than by digit count, and PairsWithFirst.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestPairMap(t *testing.T) {
+	om := NewPairMap[string, int, bool]()
+	regions := []string{"eu-west", "us-east", "us-west"}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, i := range rng.Perm(60) {
+		om.Put(Pair[string, int]{regions[i%3], i * 7}, true)
+	}
+	if err := om.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	var prev Pair[string, int]
+	n := 0
+	for k := range PairsWithFirst(om, "us-east") {
+		if k.First != "us-east" {
+			t.Errorf("PairsWithFirst: unexpected key %v", k)
+		}
+		if n > 0 && prev.Second >= k.Second {
+			t.Errorf("PairsWithFirst: %v before %v", prev, k)
+		}
+		prev = k
+		n++
+	}
+	if n != 20 {
+		t.Errorf("PairsWithFirst: expected 20 keys, got %d", n)
+	}
+	usWest := func(k Pair[string, int]) int {
+		return strings.Compare(k.First, "us-west")
+	}
+	if c := om.CountFunc(usWest); c != 20 {
+		t.Errorf("CountFunc: expected 20 keys for us-west, got %d", c)
+	}
+
+	for range PairsWithFirst(om, "ap-south") {
+		t.Error("PairsWithFirst: expected no keys for an unknown region")
+	}
+
+	first, _ := om.Min()
+	last, _ := om.Max()
+	if first != (Pair[string, int]{"eu-west", 0}) || last != (Pair[string, int]{"us-west", 413}) {
+		t.Errorf("Min/Max: got %v and %v", first, last)
+	}
+}
+
+// TestTripleMap tests an OrderedMap keyed by Triples with the TriplesWithFirst and
+// TriplesWithFirstTwo prefix helpers and CountFunc.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestTripleMap(t *testing.T) {
+	om := NewTripleMap[string, int, int, int]()
+	for _, region := range []string{"b", "a", "c"} {
+		for day := 9; day <= 11; day++ {
+			for id := 0; id < 5; id++ {
+				om.Put(Triple[string, int, int]{region, day, id}, id)
+			}
+		}
+	}
+
+	n := 0
+	for k := range TriplesWithFirst(om, "b") {
+		if k.First != "b" {
+			t.Errorf("TriplesWithFirst: unexpected key %v", k)
+		}
+		n++
+	}
+	if n != 15 {
+		t.Errorf("TriplesWithFirst: expected 15 keys, got %d", n)
+	}
+
+	var ids []int
+	for k, v := range TriplesWithFirstTwo(om, "c", 10) {
+		if k.First != "c" || k.Second != 10 || k.Third != v {
+			t.Errorf("TriplesWithFirstTwo: unexpected entry %v=%d", k, v)
+		}
+		ids = append(ids, v)
+	}
+	if len(ids) != 5 || ids[0] != 0 || ids[4] != 4 {
+		t.Errorf("TriplesWithFirstTwo: expected ids 0..4, got %v", ids)
+	}
+
+	day10 := func(k Triple[string, int, int]) int {
+		return CompareTriple(Triple[string, int, int]{k.First, k.Second, 0}, Triple[string, int, int]{"a", 10, 0})
+	}
+	if c := om.CountFunc(day10); c != 5 {
+		t.Errorf("CountFunc: expected 5 keys for a/10, got %d", c)
+	}
+}
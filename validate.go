@@ -0,0 +1,63 @@
+package orderedmap
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Validate. Each one is wrapped together with the key of the
+// node at which the invariant was found to be broken.
+var (
+	ErrKeyOrder     = errors.New("orderedmap: keys out of order")
+	ErrRedRightLink = errors.New("orderedmap: red right link")
+	ErrDoubleRed    = errors.New("orderedmap: two red links in a row")
+	ErrBlackHeight  = errors.New("orderedmap: unequal black height")
+	ErrSize         = errors.New("orderedmap: inconsistent subtree size")
+)
+
+// Validate checks the structural invariants of the underlying left-leaning
+// red-black tree: symmetric key order, consistent subtree sizes, no red right
+// links, no two red links in a row and equal black height on every path.
+// It returns nil if the tree is valid, otherwise an error wrapping one of the
+// Err* values above and naming the offending key. It runs in O(n).
+func (t *OrderedMap[K, V]) Validate() error {
+	_, err := t.validate(t.root, nil, nil)
+	return err
+}
+
+// validate checks the subtree rooted at x, whose keys must lie strictly between
+// lo and hi when those are non-nil, and returns its black height.
+func (t *OrderedMap[K, V]) validate(x *node[K, V], lo, hi *K) (int, error) {
+	if x == nil {
+		return 0, nil
+	}
+	if (lo != nil && t.compare(x.key, *lo) <= 0) || (hi != nil && t.compare(x.key, *hi) >= 0) {
+		return 0, fmt.Errorf("%w at key %v", ErrKeyOrder, x.key)
+	}
+	if x.size != t.size(x.left)+t.size(x.right)+1 {
+		return 0, fmt.Errorf("%w at key %v: have %d, want %d", ErrSize, x.key, x.size, t.size(x.left)+t.size(x.right)+1)
+	}
+	if t.isRed(x.right) {
+		return 0, fmt.Errorf("%w at key %v", ErrRedRightLink, x.key)
+	}
+	if t.isRed(x) && t.isRed(x.left) {
+		return 0, fmt.Errorf("%w at key %v", ErrDoubleRed, x.key)
+	}
+
+	left, err := t.validate(x.left, lo, &x.key)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.validate(x.right, &x.key, hi)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("%w at key %v: left %d, right %d", ErrBlackHeight, x.key, left, right)
+	}
+
+	if !t.isRed(x) {
+		left++
+	}
+	return left, nil
+}
@@ -0,0 +1,82 @@
+package orderedmap
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestValidateAfterMutations tests that Validate accepts every tree produced by a
+// random sequence of Put, Delete, DeleteMin and DeleteMax operations.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestValidateAfterMutations(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	if err := om.Validate(); err != nil {
+		t.Fatalf("empty map: %v", err)
+	}
+	for i := 0; i < 5000; i++ {
+		k := rng.Intn(500)
+		switch op := rng.Intn(10); {
+		case op < 6:
+			om.Put(k, i)
+		case op < 8:
+			om.Delete(k)
+		case op == 8 && !om.IsEmpty():
+			om.DeleteMin()
+		case op == 9 && !om.IsEmpty():
+			om.DeleteMax()
+		}
+		if err := om.Validate(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+}
+
+// TestValidateDetectsCorruption tests that Validate reports each kind of broken
+// invariant when a valid tree is corrupted by hand.
+//
+// Parameters:
+// - t: The testing.T object for running the test.
+//
+// Returns: None.
+func TestValidateDetectsCorruption(t *testing.T) {
+	build := func() *OrderedMap[int, int] {
+		om := NewOrderedMap[int, int]()
+		for i := 0; i < 15; i++ {
+			om.Put(i, i)
+		}
+		return om
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(om *OrderedMap[int, int])
+		want    error
+	}{
+		{"key order", func(om *OrderedMap[int, int]) { om.root.left.key = 100 }, ErrKeyOrder},
+		{"size", func(om *OrderedMap[int, int]) { om.root.right.size++ }, ErrSize},
+		{"red right link", func(om *OrderedMap[int, int]) { om.root.right.color = RED }, ErrRedRightLink},
+		{"double red", func(om *OrderedMap[int, int]) {
+			om.root.left.color = RED
+			om.root.left.left.color = RED
+		}, ErrDoubleRed},
+		{"black height", func(om *OrderedMap[int, int]) { om.root.left.left.color = RED }, ErrBlackHeight},
+	}
+	for _, tc := range tests {
+		om := build()
+		if err := om.Validate(); err != nil {
+			t.Fatalf("%s: valid tree rejected: %v", tc.name, err)
+		}
+		tc.corrupt(om)
+		if err := om.Validate(); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+}